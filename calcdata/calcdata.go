@@ -2,7 +2,11 @@ package calcdata
 
 import (
 	"bytes"
+	"encoding/xml"
 	"io"
+	"reflect"
+	"runtime"
+	"sync"
 
 	"github.com/goark/csvdata"
 	"github.com/goark/errs"
@@ -11,11 +15,36 @@ import (
 
 // Reader is class of LibreOffice Calc data
 type Reader struct {
-	table          *ods.Table
+	table          *typedTable
+	stream         *rowStream // streaming mode
 	offset, repeat int
+	rowNum         int // row number of the last returned record
+	cursor         int // row number of the last scanned row
+	trimmer        csvdata.Trimmer
+	fillMerged     bool
+	prev           []string // previous row in fillMerged mode
+	current        *tableRow
+	typed          *tableRow // the last scanned row for Cell method
+	typedRow       int
 }
 
-var _ csvdata.RowsReader = (*Reader)(nil) //Reader is compatible with csvdata.RowsReader interface
+var (
+	_ csvdata.RowsReader       = (*Reader)(nil) //Reader is compatible with csvdata.RowsReader interface
+	_ csvdata.PositionReader   = (*Reader)(nil) //Reader is compatible with csvdata.PositionReader interface
+	_ csvdata.DateSystemReader = (*Reader)(nil) //Reader is compatible with csvdata.DateSystemReader interface
+)
+
+// typedTable is a table (sheet) in Calc file.
+// Unlike ods.Table, it keeps typed values of cells (date, time, boolean and so on) and rows in row groups.
+type typedTable struct {
+	name   string
+	hidden bool // table:display="false" in table style
+	rows   []tableRow
+}
+
+// typedDocs is map of ods.Doc instances opened by this package (address of ods.Doc) to their typed tables.
+// Entries are removed by finalizer of ods.Doc, so that the map does not keep ods.Doc instances alive.
+var typedDocs sync.Map
 
 // OpenFile returns Calc file instance.
 func OpenFile(path string) (*ods.Doc, error) {
@@ -27,12 +56,47 @@ func OpenFile(path string) (*ods.Doc, error) {
 }
 
 // New function creates a new Reader instance.
+// Typed values of cells (Cell method) are available if doc is opened by OpenFile, OpenReader, OpenReaderAt or OpenFS function.
+// Otherwise (e.g. doc is parsed by ods.File.ParseContent method), cells except numbers are string values.
 func New(doc *ods.Doc, sheetName string) (*Reader, error) {
 	index := sheetIndex(doc, sheetName)
 	if index < 0 {
 		return nil, errs.Wrap(csvdata.ErrInvalidSheetName, errs.WithContext("sheetName", sheetName))
 	}
-	return &Reader{table: &doc.Table[index]}, nil
+	return &Reader{table: tableOf(doc, index)}, nil
+}
+
+// newDoc function returns ods.Doc instance from typed tables, and registers them.
+func newDoc(tables []typedTable) *ods.Doc {
+	doc := &ods.Doc{Table: make([]ods.Table, len(tables))}
+	for i := range tables {
+		table := &tables[i]
+		rows := make([]ods.Row, len(table.rows))
+		for j := range table.rows {
+			rows[j] = table.rows[j].odsRow()
+		}
+		doc.Table[i] = ods.Table{Name: table.name, Row: rows}
+	}
+	key := reflect.ValueOf(doc).Pointer()
+	typedDocs.Store(key, tables)
+	runtime.SetFinalizer(doc, func(*ods.Doc) { typedDocs.Delete(key) })
+	return doc
+}
+
+// tableOf function returns typed table of index-th table in doc.
+// If doc is not opened by this package (or tables are modified), typed table is made from ods.Table.
+func tableOf(doc *ods.Doc, index int) *typedTable {
+	table := &doc.Table[index]
+	if v, ok := typedDocs.Load(reflect.ValueOf(doc).Pointer()); ok {
+		if tables := v.([]typedTable); len(tables) == len(doc.Table) && tables[index].name == table.Name && len(tables[index].rows) == len(table.Row) {
+			return &tables[index]
+		}
+	}
+	rows := make([]tableRow, len(table.Row))
+	for i := range table.Row {
+		rows[i] = newTableRow(&table.Row[i])
+	}
+	return &typedTable{name: table.Name, rows: rows}
 }
 
 // TrimSpace returns false.
@@ -40,26 +104,91 @@ func (r *Reader) TrimSpace() bool {
 	return false
 }
 
+// DateSystem method returns csvdata.DateSystemODF.
+// This method is a implementation of csvdata.DateSystemReader interface.
+func (r *Reader) DateSystem() csvdata.DateSystem {
+	return csvdata.DateSystemODF
+}
+
 // LazyQuotes returns true.
 func (r *Reader) LazyQuotes() bool {
 	return true
 }
 
+// WithTrim method sets mode for trimming empty rows and columns.
+func (r *Reader) WithTrim(mode csvdata.TrimMode) *Reader {
+	if r == nil {
+		return nil
+	}
+	r.trimmer.Mode = mode
+	return r
+}
+
+// Read method returns next row data.
 func (r *Reader) Read() ([]string, error) {
-	if r == nil || r.table == nil {
+	if r == nil || (r.table == nil && r.stream == nil) {
 		return nil, errs.Wrap(csvdata.ErrNullPointer)
 	}
-	if r.offset >= len(r.table.Row) {
-		return nil, errs.Wrap(io.EOF)
+	cols, row, err := r.trimmer.ReadBlock(r.readRow)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	r.rowNum = row
+	return cols, nil
+}
+
+// readRow method returns next row data with row number and number of rows.
+// Repeated empty rows are returned at once if they are trimmed.
+func (r *Reader) readRow() ([]string, int, int, error) {
+	row, err := r.nextRow()
+	if err != nil {
+		return nil, 0, 0, errs.Wrap(err)
+	}
+	if n := row.RepeatedRows; n > 1 && r.repeat == 0 && !r.fillMerged && r.trimmer.Mode&(csvdata.TrimEmptyRows|csvdata.TrimTrailingRows) != 0 && row.isEmpty() {
+		r.cursor += n
+		r.offset++
+		return []string{}, r.cursor - n + 1, n, nil
 	}
-	row := r.table.Row[r.offset]
-	cols := row.Strings(&bytes.Buffer{})
+	cols := row.strings(&bytes.Buffer{})
+	if r.fillMerged {
+		cols = r.fill(row.Cell, cols)
+	}
+	r.cursor++
+	r.typed, r.typedRow = row, r.cursor
 	r.repeat++
 	if r.repeat >= row.RepeatedRows {
 		r.offset++
 		r.repeat = 0
 	}
-	return cols, nil
+	return cols, r.cursor, 1, nil
+}
+
+// nextRow method returns row to be read (the same row while repeating).
+func (r *Reader) nextRow() (*tableRow, error) {
+	if r.repeat > 0 && r.current != nil {
+		return r.current, nil
+	}
+	if r.stream != nil {
+		row, err := r.stream.next()
+		if err != nil {
+			return nil, errs.Wrap(err)
+		}
+		r.current = row
+		return row, nil
+	}
+	if r.offset >= len(r.table.rows) {
+		return nil, io.EOF
+	}
+	r.current = &r.table.rows[r.offset]
+	return r.current, nil
+}
+
+// Position method returns position of field in the last read row.
+func (r *Reader) Position(field int) csvdata.Position {
+	if r == nil || r.rowNum == 0 || field < 0 {
+		return csvdata.Position{}
+	}
+	return csvdata.Position{Line: r.rowNum, Column: field + 1, Cell: csvdata.CellName(field+1, r.rowNum)}
 }
 
 func openFile(path string) (*ods.Doc, error) {
@@ -67,12 +196,121 @@ func openFile(path string) (*ods.Doc, error) {
 	if err != nil {
 		return nil, errs.Wrap(err, errs.WithContext("path", path))
 	}
-	defer f.Close()
-	var doc ods.Doc
-	if err := f.ParseContent(&doc); err != nil {
+	doc, err := parseContent(f)
+	if err != nil {
 		return nil, errs.Wrap(err, errs.WithContext("path", path))
 	}
-	return &doc, nil
+	return doc, nil
+}
+
+// parseContent function parses content.xml (and styles.xml for visibility of tables) in Calc file and closes the file.
+func parseContent(f *ods.File) (*ods.Doc, error) {
+	defer f.Close()
+	hidden := map[string]bool{}
+	if styles, err := f.Open("styles.xml"); err == nil {
+		err := decodeStyles(styles, hidden)
+		_ = styles.Close()
+		if err != nil {
+			return nil, errs.Wrap(err)
+		}
+	}
+	content, err := f.Open("content.xml")
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	defer content.Close()
+	tables, err := decodeContent(content, hidden)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return newDoc(tables), nil
+}
+
+// decodeContent function decodes tables in content.xml. Rows in row groups (table-row-group, table-header-rows and so on) are also decoded.
+// hidden is map of table styles with table:display="false" attribute, and automatic styles in content.xml are added to it.
+func decodeContent(r io.Reader, hidden map[string]bool) ([]typedTable, error) {
+	tables := []typedTable{}
+	styles := []string{} // table:style-name of each table
+	decoder := xml.NewDecoder(r)
+	current := -1 // index of table in decoding
+	for {
+		tok, err := decoder.Token()
+		if err != nil {
+			if !errs.Is(err, io.EOF) {
+				return nil, errs.Wrap(err)
+			}
+			for i := range tables {
+				tables[i].hidden = hidden[styles[i]]
+			}
+			return tables, nil
+		}
+		switch el := tok.(type) {
+		case xml.StartElement:
+			if isTableStyle(el) {
+				if err := decodeTableStyle(decoder, el, hidden); err != nil {
+					return nil, errs.Wrap(err)
+				}
+				continue
+			}
+			if el.Name.Space != tableNS {
+				continue
+			}
+			switch {
+			case el.Name.Local == "table":
+				tables = append(tables, typedTable{name: tableName(el)})
+				styles = append(styles, attrValue(el, tableNS, "style-name"))
+				current = len(tables) - 1
+			case el.Name.Local == "table-row" && current >= 0:
+				var row tableRow
+				if err := decoder.DecodeElement(&row, &el); err != nil {
+					return nil, errs.Wrap(err)
+				}
+				tables[current].rows = append(tables[current].rows, row)
+			}
+		case xml.EndElement:
+			if el.Name.Space == tableNS && el.Name.Local == "table" {
+				current = -1
+			}
+		}
+	}
+}
+
+// decodeStyles function decodes table styles in styles.xml into hidden.
+func decodeStyles(r io.Reader, hidden map[string]bool) error {
+	decoder := xml.NewDecoder(r)
+	for {
+		tok, err := decoder.Token()
+		if err != nil {
+			if errs.Is(err, io.EOF) {
+				return nil
+			}
+			return errs.Wrap(err)
+		}
+		if el, ok := tok.(xml.StartElement); ok && isTableStyle(el) {
+			if err := decodeTableStyle(decoder, el, hidden); err != nil {
+				return errs.Wrap(err)
+			}
+		}
+	}
+}
+
+// isTableStyle function returns true if el is style:style element of table family.
+func isTableStyle(el xml.StartElement) bool {
+	return el.Name.Space == styleNS && el.Name.Local == "style" && attrValue(el, styleNS, "family") == "table"
+}
+
+// decodeTableStyle function decodes style:style element of table, and records it in hidden if table:display attribute is false.
+func decodeTableStyle(decoder *xml.Decoder, el xml.StartElement, hidden map[string]bool) error {
+	var style struct {
+		Properties struct {
+			Display string `xml:"urn:oasis:names:tc:opendocument:xmlns:table:1.0 display,attr"`
+		} `xml:"table-properties"`
+	}
+	if err := decoder.DecodeElement(&style, &el); err != nil {
+		return errs.Wrap(err)
+	}
+	hidden[attrValue(el, styleNS, "name")] = style.Properties.Display == "false"
+	return nil
 }
 
 func sheetIndex(doc *ods.Doc, s string) int {
@@ -90,9 +328,12 @@ func sheetIndex(doc *ods.Doc, s string) int {
 	return -1
 }
 
-// Close method is dummy.
+// Close method closes Calc file in streaming mode, or is dummy otherwise.
 func (r *Reader) Close() error {
-	return nil
+	if r == nil || r.stream == nil {
+		return nil
+	}
+	return r.stream.close()
 }
 
 /* Copyright 2021 Spiegel
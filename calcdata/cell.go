@@ -0,0 +1,233 @@
+package calcdata
+
+import (
+	"bytes"
+	"encoding/xml"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/goark/csvdata"
+	"github.com/knieriem/odf/ods"
+)
+
+var _ csvdata.TypedReader = (*Reader)(nil) //Reader is compatible with csvdata.TypedReader interface
+
+// tableRow is table:table-row element with typed values of cells.
+type tableRow struct {
+	RepeatedRows int         `xml:"number-rows-repeated,attr"`
+	Cell         []tableCell `xml:",any"` // table-cell and covered-table-cell
+}
+
+// tableCell is table:table-cell element with typed value (office:value-type and so on).
+// Unlike ods.Cell, it keeps office:date-value, office:time-value and office:boolean-value attributes.
+type tableCell struct {
+	XMLName      xml.Name
+	ValueType    string    `xml:"value-type,attr"`
+	Value        string    `xml:"value,attr"`
+	DateValue    string    `xml:"date-value,attr"`
+	TimeValue    string    `xml:"time-value,attr"`
+	BooleanValue string    `xml:"boolean-value,attr"`
+	Formula      string    `xml:"formula,attr"`
+	RepeatedCols int       `xml:"number-columns-repeated,attr"`
+	ColSpan      int       `xml:"number-columns-spanned,attr"`
+	P            []ods.Par `xml:"p"`
+}
+
+// newTableRow function returns tableRow instance from ods.Row (typed values except numbers are not available).
+func newTableRow(row *ods.Row) tableRow {
+	cells := make([]tableCell, len(row.Cell))
+	for i := range row.Cell {
+		c := &row.Cell[i]
+		cells[i] = tableCell{XMLName: c.XMLName, ValueType: c.ValueType, Value: c.Value, Formula: c.Formula, RepeatedCols: c.RepeatedCols, ColSpan: c.ColSpan, P: c.P}
+	}
+	return tableRow{RepeatedRows: row.RepeatedRows, Cell: cells}
+}
+
+// odsRow method returns ods.Row instance of the row.
+func (row *tableRow) odsRow() ods.Row {
+	cells := make([]ods.Cell, len(row.Cell))
+	for i := range row.Cell {
+		c := &row.Cell[i]
+		cells[i] = ods.Cell{XMLName: c.XMLName, ValueType: c.ValueType, Value: c.Value, Formula: c.Formula, RepeatedCols: c.RepeatedCols, ColSpan: c.ColSpan, P: c.P}
+	}
+	return ods.Row{RepeatedRows: row.RepeatedRows, Cell: cells}
+}
+
+// strings method returns contents of the row as a slice of strings (the same as ods.Row.Strings method).
+// Trailing empty cells are removed, and covered cells appear as empty strings.
+func (row *tableRow) strings(b *bytes.Buffer) []string {
+	if len(row.Cell) == 0 {
+		return nil
+	}
+	cells := row.Cell
+	for len(cells) > 0 && cells[len(cells)-1].isEmpty() {
+		cells = cells[:len(cells)-1]
+	}
+	n := 0
+	for i := range cells {
+		n += max(cells[i].RepeatedCols, 1)
+	}
+	cols := make([]string, 0, n)
+	for i := range cells {
+		c := &cells[i]
+		s := ""
+		if c.XMLName.Local != "covered-table-cell" {
+			s = c.plainText(b)
+		}
+		for j := 0; j < max(c.RepeatedCols, 1); j++ {
+			cols = append(cols, s)
+		}
+	}
+	return cols
+}
+
+// isEmpty method returns true if all cells in the row are empty.
+func (row *tableRow) isEmpty() bool {
+	for i := range row.Cell {
+		if !row.Cell[i].isEmpty() {
+			return false
+		}
+	}
+	return true
+}
+
+// at method returns cell of field in the row.
+func (row *tableRow) at(field int) (*tableCell, bool) {
+	w := 0
+	for i := range row.Cell {
+		c := &row.Cell[i]
+		w += max(c.RepeatedCols, 1)
+		if field < w {
+			return c, true
+		}
+	}
+	return nil, false
+}
+
+// Cell method returns typed value of field in the last read row (office:value-type attribute).
+// This method is a implementation of csvdata.TypedReader interface.
+func (r *Reader) Cell(field int) (csvdata.Cell, bool) {
+	if r == nil || r.typed == nil || r.typedRow != r.rowNum || field < 0 {
+		return csvdata.Cell{}, false
+	}
+	tc, ok := r.typed.at(field)
+	if !ok || tc.XMLName.Local == "covered-table-cell" {
+		return csvdata.Cell{}, false
+	}
+	return tc.value(), true
+}
+
+// value method returns typed value of the cell.
+func (tc *tableCell) value() csvdata.Cell {
+	c := csvdata.Cell{Type: csvdata.CellString, Formula: tc.Formula}
+	switch tc.ValueType {
+	case "float", "percentage", "currency":
+		if f, err := strconv.ParseFloat(tc.Value, 64); err == nil {
+			c.Type, c.Value, c.Number = csvdata.CellNumber, tc.Value, f
+			return c
+		}
+	case "date":
+		if tm, ok := parseDateValue(tc.DateValue); ok {
+			c.Type, c.Value, c.Time, c.Number = csvdata.CellDate, tc.DateValue, tm, serial(tm)
+			return c
+		}
+	case "time":
+		if d, ok := parseDuration(tc.TimeValue); ok {
+			c.Type, c.Value, c.Time, c.Number = csvdata.CellDate, tc.TimeValue, odfEpoch.Add(d), d.Hours()/24
+			return c
+		}
+	case "boolean":
+		if b, err := strconv.ParseBool(tc.BooleanValue); err == nil {
+			c.Type, c.Value, c.Bool = csvdata.CellBool, tc.BooleanValue, b
+			return c
+		}
+	case "error":
+		c.Type = csvdata.CellError
+	}
+	c.Value = tc.plainText(&bytes.Buffer{})
+	if len(tc.ValueType) == 0 && len(c.Value) == 0 {
+		c.Type = csvdata.CellEmpty
+	}
+	return c
+}
+
+// isEmpty method returns true if the cell has no text.
+func (tc *tableCell) isEmpty() bool {
+	return len(tc.P) == 0 || (len(tc.P) == 1 && len(tc.P[0].XML) == 0)
+}
+
+// plainText method returns text of the cell.
+func (tc *tableCell) plainText(b *bytes.Buffer) string {
+	if len(tc.P) == 1 {
+		return tc.P[0].PlainText(b)
+	}
+	return (&ods.Cell{P: tc.P}).PlainText(b)
+}
+
+// odfEpoch is origin of serial number in ODF (csvdata.DateSystemODF).
+var odfEpoch = time.Date(1899, time.December, 30, 0, 0, 0, 0, time.UTC)
+
+// serial function returns serial number of date by csvdata.DateSystemODF.
+func serial(tm time.Time) float64 {
+	y, m, d := tm.Date()
+	days := time.Date(y, m, d, 0, 0, 0, 0, time.UTC).Sub(odfEpoch).Hours() / 24
+	clock := tm.Sub(time.Date(y, m, d, 0, 0, 0, 0, tm.Location()))
+	return days + clock.Hours()/24
+}
+
+var dateValueLayouts = []string{
+	"2006-01-02T15:04:05.999999999Z07:00",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02",
+}
+
+// parseDateValue function parses office:date-value attribute (xsd:date or xsd:dateTime).
+func parseDateValue(s string) (time.Time, bool) {
+	for _, layout := range dateValueLayouts {
+		if tm, err := time.Parse(layout, s); err == nil {
+			return tm, true
+		}
+	}
+	return time.Time{}, false
+}
+
+var durationPattern = regexp.MustCompile(`^(-)?P(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+(?:\.\d+)?)S)?)?$`)
+
+// parseDuration function parses office:time-value attribute (xsd:duration, e.g. "PT12H30M00S").
+func parseDuration(s string) (time.Duration, bool) {
+	m := durationPattern.FindStringSubmatch(s)
+	if m == nil || s == "P" || s == "PT" {
+		return 0, false
+	}
+	var d time.Duration
+	for i, unit := range []time.Duration{24 * time.Hour, time.Hour, time.Minute, time.Second} {
+		if len(m[i+2]) == 0 {
+			continue
+		}
+		f, err := strconv.ParseFloat(m[i+2], 64)
+		if err != nil {
+			return 0, false
+		}
+		d += time.Duration(f * float64(unit))
+	}
+	if len(m[1]) > 0 {
+		d = -d
+	}
+	return d, true
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
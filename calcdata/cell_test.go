@@ -0,0 +1,146 @@
+package calcdata_test
+
+import (
+	"bytes"
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/goark/csvdata"
+	"github.com/goark/csvdata/calcdata"
+	"github.com/knieriem/odf/ods"
+)
+
+const typedContent = `<?xml version="1.0" encoding="UTF-8"?>
+<office:document-content xmlns:office="urn:oasis:names:tc:opendocument:xmlns:office:1.0" xmlns:table="urn:oasis:names:tc:opendocument:xmlns:table:1.0" xmlns:text="urn:oasis:names:tc:opendocument:xmlns:text:1.0" xmlns:calcext="urn:org:documentfoundation:names:experimental:calc:xmlns:calcext:1.0">
+<office:body><office:spreadsheet><table:table table:name="Sheet1">
+<table:table-row>
+<table:table-cell office:value-type="string"><text:p>name</text:p></table:table-cell>
+<table:table-cell office:value-type="string"><text:p>rate</text:p></table:table-cell>
+<table:table-cell office:value-type="string"><text:p>price</text:p></table:table-cell>
+<table:table-cell office:value-type="string"><text:p>date</text:p></table:table-cell>
+<table:table-cell office:value-type="string"><text:p>time</text:p></table:table-cell>
+<table:table-cell office:value-type="string"><text:p>flag</text:p></table:table-cell>
+<table:table-cell office:value-type="string"><text:p>error</text:p></table:table-cell>
+</table:table-row>
+<table:table-row>
+<table:table-cell office:value-type="string"><text:p>Earth</text:p></table:table-cell>
+<table:table-cell office:value-type="percentage" office:value="0.125"><text:p>12.5%</text:p></table:table-cell>
+<table:table-cell table:formula="of:=[.B2]*10000" office:value-type="currency" office:currency="JPY" office:value="1250"><text:p>￥1,250</text:p></table:table-cell>
+<table:table-cell office:value-type="date" office:date-value="2023-03-14T12:00:00"><text:p>3/14/23</text:p></table:table-cell>
+<table:table-cell office:value-type="time" office:time-value="PT06H30M00S"><text:p>06:30 AM</text:p></table:table-cell>
+<table:table-cell office:value-type="boolean" office:boolean-value="true"><text:p>TRUE</text:p></table:table-cell>
+<table:table-cell table:formula="of:=1/0" office:value-type="string" office:string-value="" calcext:value-type="error"><text:p>#DIV/0!</text:p></table:table-cell>
+</table:table-row>
+</table:table></office:spreadsheet></office:body>
+</office:document-content>`
+
+func TestCell(t *testing.T) {
+	data := odsData(t, typedContent)
+	r, err := calcdata.NewStream(bytes.NewReader(data), int64(len(data)), "")
+	if err != nil {
+		t.Fatalf("NewStream() is \"%+v\", want nil.", err)
+	}
+	testTypedCells(t, r)
+}
+
+func TestCellDoc(t *testing.T) {
+	data := odsData(t, typedContent)
+	doc, err := calcdata.OpenReaderAt(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("OpenReaderAt() is \"%+v\", want nil.", err)
+	}
+	if rows := doc.Table[0].Strings(); len(rows) != 2 || rows[0][0] != "name" {
+		t.Errorf("Strings() is %q, want 2 rows.", rows)
+	}
+	r, err := calcdata.New(doc, "")
+	if err != nil {
+		t.Fatalf("New() is \"%+v\", want nil.", err)
+	}
+	testTypedCells(t, r)
+}
+
+func TestCellODSDoc(t *testing.T) {
+	doc := &ods.Doc{}
+	if err := xml.Unmarshal([]byte(typedContent), doc); err != nil {
+		t.Fatal(err)
+	}
+	r, err := calcdata.New(doc, "")
+	if err != nil {
+		t.Fatalf("New() is \"%+v\", want nil.", err)
+	}
+	rc := csvdata.NewRows(r, true)
+	if err := rc.Next(); err != nil {
+		t.Fatalf("Next() is \"%+v\", want nil.", err)
+	}
+	if f, err := rc.ColumnFloat64("rate"); err != nil || f != 0.125 {
+		t.Errorf("ColumnFloat64() is %v, \"%+v\", want %v.", f, err, 0.125)
+	}
+	if c, ok := r.Cell(3); !ok || c.Type != csvdata.CellString || c.Value != "3/14/23" {
+		t.Errorf("Cell(3) is %+v, want string value.", c)
+	}
+}
+
+func testTypedCells(t *testing.T, r *calcdata.Reader) {
+	t.Helper()
+	rc := csvdata.NewRows(r, true)
+	defer rc.Close()
+	if err := rc.Next(); err != nil {
+		t.Fatalf("Next() is \"%+v\", want nil.", err)
+	}
+	date := time.Date(2023, time.March, 14, 12, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		field   int
+		typ     csvdata.CellType
+		value   string
+		formula string
+		number  float64
+		b       bool
+		tm      time.Time
+	}{
+		{field: 0, typ: csvdata.CellString, value: "Earth"},
+		{field: 1, typ: csvdata.CellNumber, value: "0.125", number: 0.125},
+		{field: 2, typ: csvdata.CellNumber, value: "1250", formula: "of:=[.B2]*10000", number: 1250},
+		{field: 3, typ: csvdata.CellDate, value: "2023-03-14T12:00:00", number: 44999.5, tm: date},
+		{field: 4, typ: csvdata.CellDate, value: "PT06H30M00S", number: 6.5 / 24, tm: time.Date(1899, time.December, 30, 6, 30, 0, 0, time.UTC)},
+		{field: 5, typ: csvdata.CellBool, value: "true", b: true},
+		{field: 6, typ: csvdata.CellError, value: "#DIV/0!", formula: "of:=1/0"},
+	}
+	for _, tc := range testCases {
+		c, ok := r.Cell(tc.field)
+		if !ok {
+			t.Errorf("Cell(%v) is not available.", tc.field)
+			continue
+		}
+		if c.Type != tc.typ || c.Value != tc.value || c.Formula != tc.formula || c.Number != tc.number || c.Bool != tc.b || !c.Time.Equal(tc.tm) {
+			t.Errorf("Cell(%v) is %+v, want %+v.", tc.field, c, tc)
+		}
+	}
+	if f, err := rc.ColumnFloat64("rate"); err != nil || f != 0.125 {
+		t.Errorf("ColumnFloat64() is %v, \"%+v\", want %v.", f, err, 0.125)
+	}
+	if tm, err := rc.ColumnTime("date", ""); err != nil || !tm.Equal(date) {
+		t.Errorf("ColumnTime() is %v, \"%+v\", want %v.", tm, err, date)
+	}
+	if b, err := rc.ColumnBool("flag"); err != nil || !b {
+		t.Errorf("ColumnBool() is %v, \"%+v\", want %v.", b, err, true)
+	}
+	if _, ok := r.Cell(7); ok {
+		t.Error("Cell(7) is available, want not.")
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
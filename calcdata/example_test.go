@@ -30,6 +30,35 @@ func ExampleNew() {
 	// Mercury
 }
 
+func Example_all() {
+	ods, err := calcdata.OpenFile("testdata/sample.ods")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	r, err := calcdata.New(ods, "")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	rc := csvdata.NewRows(r, true)
+	defer rc.Close() //dummy
+
+	for _, row := range rc.All() {
+		if name := row.Column("name"); len(name) > 0 { // skip empty rows
+			fmt.Println(name)
+		}
+	}
+	if err := rc.Err(); err != nil {
+		fmt.Println(err)
+	}
+	// Output:
+	// Mercury
+	//  Venus
+	//  Earth
+	//  Mars
+}
+
 /* Copyright 2021 Spiegel
  *
  * Licensed under the Apache License, Version 2.0 (the "License");
@@ -0,0 +1,64 @@
+package calcdata
+
+// WithFillMerged method sets mode for filling all cells in merged region with value of the top-left cell.
+// Covered cells in the same row are filled by spanning cell (number-columns-spanned), and others are filled by cell above.
+func (r *Reader) WithFillMerged(mode bool) *Reader {
+	if r == nil {
+		return nil
+	}
+	r.fillMerged = mode
+	return r
+}
+
+// fill method fills covered cells in cols with value of the spanning cell.
+func (r *Reader) fill(cells []tableCell, cols []string) []string {
+	w, anchor, end := 0, -1, 0
+	for _, c := range cells {
+		n := c.RepeatedCols
+		if n < 1 {
+			n = 1
+		}
+		for j := 0; j < n; j, w = j+1, w+1 {
+			if c.XMLName.Local != "covered-table-cell" {
+				anchor, end = -1, 0
+				if c.ColSpan > 1 {
+					anchor, end = w, w+c.ColSpan
+				}
+				continue
+			}
+			var value string
+			switch {
+			case anchor >= 0 && w < end:
+				if anchor < len(cols) {
+					value = cols[anchor]
+				}
+			case w < len(r.prev):
+				value = r.prev[w]
+			}
+			if len(value) == 0 {
+				continue
+			}
+			for len(cols) <= w {
+				cols = append(cols, "")
+			}
+			cols[w] = value
+		}
+	}
+	r.prev = cols
+	return cols
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
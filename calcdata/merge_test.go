@@ -0,0 +1,84 @@
+package calcdata_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/goark/csvdata/calcdata"
+)
+
+const mergedContent = `<?xml version="1.0" encoding="UTF-8"?>
+<office:document-content xmlns:office="urn:oasis:names:tc:opendocument:xmlns:office:1.0" xmlns:table="urn:oasis:names:tc:opendocument:xmlns:table:1.0" xmlns:text="urn:oasis:names:tc:opendocument:xmlns:text:1.0">
+<office:body><office:spreadsheet><table:table table:name="Sheet1">
+<table:table-row>
+<table:table-cell><text:p>name</text:p></table:table-cell>
+<table:table-cell table:number-columns-spanned="3"><text:p>Q1</text:p></table:table-cell>
+<table:covered-table-cell table:number-columns-repeated="2"/>
+</table:table-row>
+<table:table-row>
+<table:table-cell table:number-rows-spanned="2"><text:p>Earth</text:p></table:table-cell>
+<table:table-cell><text:p>1</text:p></table:table-cell>
+<table:table-cell table:number-columns-spanned="2" table:number-rows-spanned="2"><text:p>2</text:p></table:table-cell>
+<table:covered-table-cell/>
+</table:table-row>
+<table:table-row>
+<table:covered-table-cell/>
+<table:table-cell><text:p>3</text:p></table:table-cell>
+<table:covered-table-cell table:number-columns-repeated="2"/>
+</table:table-row>
+</table:table></office:spreadsheet></office:body>
+</office:document-content>`
+
+func TestFillMerged(t *testing.T) {
+	data := odsData(t, mergedContent)
+	doc, err := calcdata.OpenReaderAt(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("OpenReaderAt() is \"%+v\", want nil.", err)
+	}
+	testCases := []struct {
+		fill bool
+		want [][]string
+	}{
+		{fill: false, want: [][]string{{"name", "Q1"}, {"Earth", "1", "2"}, {"", "3"}}},
+		{fill: true, want: [][]string{{"name", "Q1", "Q1", "Q1"}, {"Earth", "1", "2", "2"}, {"Earth", "3", "2", "2"}}},
+	}
+	for _, tc := range testCases {
+		r, err := calcdata.New(doc, "")
+		if err != nil {
+			t.Fatalf("New() is \"%+v\", want nil.", err)
+		}
+		r = r.WithFillMerged(tc.fill)
+		list := [][]string{}
+		for {
+			record, err := r.Read()
+			if err != nil {
+				if !errors.Is(err, io.EOF) {
+					t.Errorf("Read() is \"%+v\", want \"%+v\".", err, io.EOF)
+				}
+				break
+			}
+			list = append(list, record)
+		}
+		if !reflect.DeepEqual(list, tc.want) {
+			t.Errorf("WithFillMerged(%v) reads %q, want %q.", tc.fill, list, tc.want)
+		}
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
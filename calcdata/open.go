@@ -0,0 +1,102 @@
+package calcdata
+
+import (
+	"bytes"
+	"io"
+	"io/fs"
+
+	"github.com/goark/errs"
+	"github.com/knieriem/odf/ods"
+)
+
+// OpenReaderAt function returns Calc file instance from data with size (e.g. multipart.File).
+func OpenReaderAt(r io.ReaderAt, size int64) (*ods.Doc, error) {
+	f, err := ods.NewReader(r, size)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return parseContent(f)
+}
+
+// OpenReader function returns Calc file instance from io.Reader. All data is read into memory.
+func OpenReader(r io.Reader) (*ods.Doc, error) {
+	ra, size, err := readerAt(r)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return OpenReaderAt(ra, size)
+}
+
+// OpenFS function returns Calc file instance from file in fsys (e.g. embed.FS).
+func OpenFS(fsys fs.FS, name string) (*ods.Doc, error) {
+	file, err := fsys.Open(name)
+	if err != nil {
+		return nil, errs.Wrap(err, errs.WithContext("name", name))
+	}
+	defer file.Close()
+	ra, size, err := readerAt(file)
+	if err != nil {
+		return nil, errs.Wrap(err, errs.WithContext("name", name))
+	}
+	doc, err := OpenReaderAt(ra, size)
+	if err != nil {
+		return nil, errs.Wrap(err, errs.WithContext("name", name))
+	}
+	return doc, nil
+}
+
+// OpenStreamFS function opens file in fsys and creates a new Reader instance in streaming mode. (see OpenStream function)
+// If the file does not implement io.ReaderAt interface, all data is read into memory.
+func OpenStreamFS(fsys fs.FS, name, sheetName string) (*Reader, error) {
+	file, err := fsys.Open(name)
+	if err != nil {
+		return nil, errs.Wrap(err, errs.WithContext("name", name))
+	}
+	ra, size, err := readerAt(file)
+	if err != nil {
+		_ = file.Close()
+		return nil, errs.Wrap(err, errs.WithContext("name", name))
+	}
+	r, err := NewStream(ra, size, sheetName)
+	if err != nil {
+		_ = file.Close()
+		return nil, errs.Wrap(err, errs.WithContext("name", name))
+	}
+	r.stream.closer = file
+	return r, nil
+}
+
+// readerAt function returns io.ReaderAt instance and size of data in r.
+// If r is not io.ReaderAt with size (Stat or Size method), all data is read into memory.
+func readerAt(r io.Reader) (io.ReaderAt, int64, error) {
+	if ra, ok := r.(io.ReaderAt); ok {
+		switch f := r.(type) {
+		case interface{ Stat() (fs.FileInfo, error) }:
+			if info, err := f.Stat(); err == nil && info.Mode().IsRegular() {
+				return ra, info.Size(), nil
+			}
+		case interface{ Size() int64 }:
+			return ra, f.Size(), nil
+		}
+	}
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, 0, errs.Wrap(err)
+	}
+	return bytes.NewReader(b), int64(len(b)), nil
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
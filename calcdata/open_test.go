@@ -0,0 +1,87 @@
+package calcdata_test
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"reflect"
+	"testing"
+	"testing/fstest"
+
+	"github.com/goark/csvdata/calcdata"
+	"github.com/knieriem/odf/ods"
+)
+
+func TestOpenReader(t *testing.T) {
+	data, err := os.ReadFile("testdata/sample.ods")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fsys := fstest.MapFS{"sample.ods": &fstest.MapFile{Data: data}}
+	testCases := []struct {
+		name string
+		open func() (*ods.Doc, error)
+	}{
+		{name: "OpenReader", open: func() (*ods.Doc, error) { return calcdata.OpenReader(struct{ io.Reader }{bytes.NewReader(data)}) }},
+		{name: "OpenReaderAt", open: func() (*ods.Doc, error) { return calcdata.OpenReaderAt(bytes.NewReader(data), int64(len(data))) }},
+		{name: "OpenFS", open: func() (*ods.Doc, error) { return calcdata.OpenFS(fsys, "sample.ods") }},
+		{name: "OpenFS(DirFS)", open: func() (*ods.Doc, error) { return calcdata.OpenFS(os.DirFS("testdata"), "sample.ods") }},
+	}
+	want := [][]string{{"order", " name ", "mass", "distance", "habitable"}, {"1", " Mercury", "0.055", "0.4", "false"}}
+	for _, tc := range testCases {
+		doc, err := tc.open()
+		if err != nil {
+			t.Errorf("%s() is \"%+v\", want nil.", tc.name, err)
+			continue
+		}
+		r, err := calcdata.New(doc, "")
+		if err != nil {
+			t.Errorf("New() is \"%+v\", want nil.", err)
+			continue
+		}
+		got := [][]string{}
+		for range want {
+			record, err := r.Read()
+			if err != nil {
+				t.Errorf("Read() is \"%+v\", want nil.", err)
+				break
+			}
+			got = append(got, record)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%s() reads %q, want %q.", tc.name, got, want)
+		}
+	}
+	if _, err := calcdata.OpenFS(fsys, "none.ods"); err == nil {
+		t.Error("OpenFS() is nil, want error.")
+	}
+}
+
+func TestOpenStreamFS(t *testing.T) {
+	r, err := calcdata.OpenStreamFS(os.DirFS("testdata"), "sample.ods", "")
+	if err != nil {
+		t.Fatalf("OpenStreamFS() is \"%+v\", want nil.", err)
+	}
+	got := readAll(t, r)
+	if len(got) < 2 || got[1][1] != " Mercury" {
+		t.Errorf("OpenStreamFS() reads %d records, want \" Mercury\" in second record.", len(got))
+	}
+	if err := r.Close(); err != nil {
+		t.Errorf("Close() is \"%+v\", want nil.", err)
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
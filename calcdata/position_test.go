@@ -0,0 +1,52 @@
+package calcdata_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/goark/csvdata"
+	"github.com/goark/csvdata/calcdata"
+)
+
+func TestFieldError(t *testing.T) {
+	doc, err := calcdata.OpenFile("testdata/sample.ods")
+	if err != nil {
+		t.Fatalf("OpenFile() is \"%+v\", want nil.", err)
+	}
+	r, err := calcdata.New(doc, "")
+	if err != nil {
+		t.Fatalf("New() is \"%+v\", want nil.", err)
+	}
+	rc := csvdata.NewRows(r, true)
+	defer rc.Close() //dummy
+	if err := rc.Next(); err != nil {
+		t.Fatalf("Next() is \"%+v\", want nil.", err)
+	}
+	_, err = rc.ColumnInt64("name", 10)
+	if !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("ColumnInt64() is \"%+v\", want \"%+v\".", err, strconv.ErrSyntax)
+	}
+	var fe *csvdata.FieldError
+	if !errors.As(err, &fe) {
+		t.Fatalf("ColumnInt64() is \"%+v\", want *csvdata.FieldError.", err)
+	}
+	if fe.Cell != "B2" || fe.Line != 2 || fe.Column != 2 || fe.Row != 1 || fe.Name != "name" {
+		t.Errorf("FieldError is %+v, want cell B2.", fe)
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,158 @@
+package calcdata
+
+import (
+	"encoding/xml"
+	"io"
+
+	"github.com/goark/csvdata"
+	"github.com/goark/errs"
+	"github.com/knieriem/odf/ods"
+)
+
+const (
+	tableNS = "urn:oasis:names:tc:opendocument:xmlns:table:1.0"
+	styleNS = "urn:oasis:names:tc:opendocument:xmlns:style:1.0"
+)
+
+// OpenStream function opens Calc file and creates a new Reader instance in streaming mode.
+// In streaming mode, content.xml is decoded row by row at each Read method call, so that memory usage is bounded by size of a row.
+// Reader.Close method must be called after use.
+func OpenStream(path, sheetName string) (*Reader, error) {
+	f, err := ods.Open(path)
+	if err != nil {
+		return nil, errs.Wrap(err, errs.WithContext("path", path))
+	}
+	r, err := newStream(f, sheetName)
+	if err != nil {
+		return nil, errs.Wrap(err, errs.WithContext("path", path))
+	}
+	return r, nil
+}
+
+// NewStream function creates a new Reader instance in streaming mode from Calc data with size.
+// Reader.Close method must be called after use.
+func NewStream(r io.ReaderAt, size int64, sheetName string) (*Reader, error) {
+	f, err := ods.NewReader(r, size)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return newStream(f, sheetName)
+}
+
+func newStream(f *ods.File, sheetName string) (*Reader, error) {
+	s, err := openRowStream(f, sheetName)
+	if err != nil {
+		_ = f.Close()
+		return nil, errs.Wrap(err, errs.WithContext("sheetName", sheetName))
+	}
+	return &Reader{stream: s}, nil
+}
+
+// rowStream is source of rows decoded from content.xml one by one.
+type rowStream struct {
+	file    *ods.File
+	closer  io.Closer // source of file (may be nil)
+	content io.ReadCloser
+	decoder *xml.Decoder
+	row     tableRow
+	done    bool
+}
+
+// openRowStream function opens content.xml and seeks start of table by name (the first table if empty).
+func openRowStream(f *ods.File, sheetName string) (*rowStream, error) {
+	content, err := f.Open("content.xml")
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	s := &rowStream{file: f, content: content, decoder: xml.NewDecoder(content)}
+	for {
+		tok, err := s.decoder.Token()
+		if err != nil {
+			_ = content.Close()
+			if errs.Is(err, io.EOF) {
+				return nil, errs.Wrap(csvdata.ErrInvalidSheetName)
+			}
+			return nil, errs.Wrap(err)
+		}
+		if se, ok := tok.(xml.StartElement); ok && se.Name.Space == tableNS && se.Name.Local == "table" {
+			if len(sheetName) == 0 || tableName(se) == sheetName {
+				return s, nil
+			}
+			if err := s.decoder.Skip(); err != nil {
+				_ = content.Close()
+				return nil, errs.Wrap(err)
+			}
+		}
+	}
+}
+
+func tableName(se xml.StartElement) string {
+	return attrValue(se, tableNS, "name")
+}
+
+// attrValue function returns value of attribute in element by namespace and local name.
+func attrValue(se xml.StartElement, space, local string) string {
+	for _, attr := range se.Attr {
+		if attr.Name.Space == space && attr.Name.Local == local {
+			return attr.Value
+		}
+	}
+	return ""
+}
+
+// next method decodes next row in table. Rows in row groups (table-row-group, table-header-rows and so on) are also returned.
+func (s *rowStream) next() (*tableRow, error) {
+	for !s.done {
+		tok, err := s.decoder.Token()
+		if err != nil {
+			if errs.Is(err, io.EOF) {
+				s.done = true
+				break
+			}
+			return nil, errs.Wrap(err)
+		}
+		switch el := tok.(type) {
+		case xml.StartElement:
+			if el.Name.Space == tableNS && el.Name.Local == "table-row" {
+				s.row = tableRow{}
+				if err := s.decoder.DecodeElement(&s.row, &el); err != nil {
+					return nil, errs.Wrap(err)
+				}
+				return &s.row, nil
+			}
+		case xml.EndElement:
+			if el.Name.Space == tableNS && el.Name.Local == "table" {
+				s.done = true
+			}
+		}
+	}
+	return nil, errs.Wrap(io.EOF)
+}
+
+func (s *rowStream) close() error {
+	err := s.content.Close()
+	if e := s.file.Close(); e != nil && err == nil {
+		err = e
+	}
+	if s.closer != nil {
+		if e := s.closer.Close(); e != nil && err == nil {
+			err = e
+		}
+	}
+	return errs.Wrap(err)
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
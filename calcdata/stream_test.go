@@ -0,0 +1,137 @@
+package calcdata_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/goark/csvdata"
+	"github.com/goark/csvdata/calcdata"
+)
+
+const groupedContent = `<?xml version="1.0" encoding="UTF-8"?>
+<office:document-content xmlns:office="urn:oasis:names:tc:opendocument:xmlns:office:1.0" xmlns:table="urn:oasis:names:tc:opendocument:xmlns:table:1.0" xmlns:text="urn:oasis:names:tc:opendocument:xmlns:text:1.0">
+<office:body><office:spreadsheet>
+<table:table table:name="Sheet1"><table:table-row><table:table-cell><text:p>dummy</text:p></table:table-cell></table:table-row></table:table>
+<table:table table:name="Sheet2">
+<table:table-column table:number-columns-repeated="1024"/>
+<table:table-header-rows>
+<table:table-row><table:table-cell><text:p>name</text:p></table:table-cell><table:table-cell><text:p>note</text:p></table:table-cell></table:table-row>
+</table:table-header-rows>
+<table:table-row-group>
+<table:table-row table:number-rows-repeated="2"><table:table-cell table:number-columns-repeated="2"><text:p>a<text:s text:c="2"/>b</text:p></table:table-cell><table:table-cell table:number-columns-repeated="1020"/></table:table-row>
+<table:table-row><table:table-cell table:number-columns-repeated="1024"/></table:table-row>
+</table:table-row-group>
+<table:table-row><table:table-cell/><table:table-cell><text:p>line1</text:p><text:p>line2</text:p></table:table-cell></table:table-row>
+</table:table>
+</office:spreadsheet></office:body>
+</office:document-content>`
+
+func odsData(t *testing.T, content string) []byte {
+	t.Helper()
+	buf := &bytes.Buffer{}
+	zw := zip.NewWriter(buf)
+	for _, part := range []struct{ name, data string }{
+		{name: "mimetype", data: "application/vnd.oasis.opendocument.spreadsheet"},
+		{name: "content.xml", data: content},
+	} {
+		w, err := zw.Create(part.name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := io.WriteString(w, part.data); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func readAll(t *testing.T, r csvdata.RowsReader) [][]string {
+	t.Helper()
+	list := [][]string{}
+	for {
+		record, err := r.Read()
+		if err != nil {
+			if !errors.Is(err, io.EOF) {
+				t.Errorf("Read() is \"%+v\", want \"%+v\".", err, io.EOF)
+			}
+			break
+		}
+		list = append(list, record)
+	}
+	return list
+}
+
+func TestOpenStream(t *testing.T) {
+	doc, err := calcdata.OpenFile("testdata/sample.ods")
+	if err != nil {
+		t.Fatalf("OpenFile() is \"%+v\", want nil.", err)
+	}
+	r, err := calcdata.New(doc, "")
+	if err != nil {
+		t.Fatalf("New() is \"%+v\", want nil.", err)
+	}
+	want := readAll(t, r)
+
+	sr, err := calcdata.OpenStream("testdata/sample.ods", "")
+	if err != nil {
+		t.Fatalf("OpenStream() is \"%+v\", want nil.", err)
+	}
+	defer sr.Close()
+	if got := readAll(t, sr); !reflect.DeepEqual(got, want) {
+		t.Errorf("OpenStream() reads %q, want %q.", got, want)
+	}
+}
+
+func TestNewStream(t *testing.T) {
+	testCases := []struct {
+		content string
+		sheet   string
+		fill    bool
+		want    [][]string
+		err     error
+	}{
+		{content: groupedContent, sheet: "", want: [][]string{{"dummy"}}},
+		{content: groupedContent, sheet: "Sheet2", want: [][]string{{"name", "note"}, {"a  b", "a  b"}, {"a  b", "a  b"}, {}, {"", "line1\nline2"}}},
+		{content: groupedContent, sheet: "Sheet3", err: csvdata.ErrInvalidSheetName},
+		{content: mergedContent, sheet: "", fill: true, want: [][]string{{"name", "Q1", "Q1", "Q1"}, {"Earth", "1", "2", "2"}, {"Earth", "3", "2", "2"}}},
+	}
+	for _, tc := range testCases {
+		data := odsData(t, tc.content)
+		r, err := calcdata.NewStream(bytes.NewReader(data), int64(len(data)), tc.sheet)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("NewStream() is \"%+v\", want \"%+v\".", err, tc.err)
+		}
+		if err != nil {
+			continue
+		}
+		r = r.WithFillMerged(tc.fill)
+		if got := readAll(t, r); !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("NewStream(%q) reads %q, want %q.", tc.sheet, got, tc.want)
+		}
+		if err := r.Close(); err != nil {
+			t.Errorf("Close() is \"%+v\", want nil.", err)
+		}
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,122 @@
+package calcdata_test
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/goark/csvdata"
+	"github.com/goark/csvdata/calcdata"
+)
+
+func TestWithTrim(t *testing.T) {
+	doc, err := calcdata.OpenFile("testdata/sample.ods")
+	if err != nil {
+		t.Fatalf("OpenFile() is \"%+v\", want nil.", err)
+	}
+	testCases := []struct {
+		mode csvdata.TrimMode
+		rows int
+	}{
+		{mode: csvdata.TrimTrailingRows, rows: 5},
+		{mode: csvdata.TrimEmptyRows, rows: 5},
+	}
+	for _, tc := range testCases {
+		r, err := calcdata.New(doc, "")
+		if err != nil {
+			t.Fatalf("New() is \"%+v\", want nil.", err)
+		}
+		if got := readAll(t, r.WithTrim(tc.mode)); len(got) != tc.rows {
+			t.Errorf("WithTrim(%v) reads %d rows, want %d.", tc.mode, len(got), tc.rows)
+		}
+	}
+}
+
+func TestWithTrimStream(t *testing.T) {
+	data := odsData(t, groupedContent)
+	testCases := []struct {
+		mode csvdata.TrimMode
+		want [][]string
+		line int
+	}{
+		{mode: csvdata.TrimTrailingRows, want: [][]string{{"name", "note"}, {"a  b", "a  b"}, {"a  b", "a  b"}, {}, {"", "line1\nline2"}}, line: 5},
+		{mode: csvdata.TrimEmptyRows, want: [][]string{{"name", "note"}, {"a  b", "a  b"}, {"a  b", "a  b"}, {"", "line1\nline2"}}, line: 5},
+	}
+	for _, tc := range testCases {
+		r, err := calcdata.NewStream(bytes.NewReader(data), int64(len(data)), "Sheet2")
+		if err != nil {
+			t.Fatalf("NewStream() is \"%+v\", want nil.", err)
+		}
+		r = r.WithTrim(tc.mode)
+		if got := readAll(t, r); !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("WithTrim(%v) reads %q, want %q.", tc.mode, got, tc.want)
+		}
+		if pos := r.Position(1); pos.Line != tc.line || pos.Cell != "B5" {
+			t.Errorf("Position() is %+v, want line %v.", pos, tc.line)
+		}
+		_ = r.Close()
+	}
+}
+
+const repeatedContent = `<?xml version="1.0" encoding="UTF-8"?>
+<office:document-content xmlns:office="urn:oasis:names:tc:opendocument:xmlns:office:1.0" xmlns:table="urn:oasis:names:tc:opendocument:xmlns:table:1.0" xmlns:text="urn:oasis:names:tc:opendocument:xmlns:text:1.0">
+<office:body><office:spreadsheet>
+<table:table table:name="Sheet1">
+<table:table-row><table:table-cell><text:p>name</text:p></table:table-cell></table:table-row>
+<table:table-row table:number-rows-repeated="2"><table:table-cell table:number-columns-repeated="1024"/></table:table-row>
+<table:table-row><table:table-cell><text:p>Earth</text:p></table:table-cell></table:table-row>
+<table:table-row table:number-rows-repeated="1048572"><table:table-cell table:number-columns-repeated="1024"/></table:table-row>
+</table:table>
+</office:spreadsheet></office:body>
+</office:document-content>`
+
+func TestWithTrimRepeated(t *testing.T) {
+	data := odsData(t, repeatedContent)
+	doc, err := calcdata.OpenReaderAt(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("OpenReaderAt() is \"%+v\", want nil.", err)
+	}
+	testCases := []struct {
+		mode csvdata.TrimMode
+		want [][]string
+	}{
+		{mode: csvdata.TrimTrailingRows, want: [][]string{{"name"}, {}, {}, {"Earth"}}},
+		{mode: csvdata.TrimEmptyRows, want: [][]string{{"name"}, {"Earth"}}},
+	}
+	for _, tc := range testCases {
+		for _, stream := range []bool{false, true} {
+			var r *calcdata.Reader
+			if stream {
+				r, err = calcdata.NewStream(bytes.NewReader(data), int64(len(data)), "")
+			} else {
+				r, err = calcdata.New(doc, "")
+			}
+			if err != nil {
+				t.Fatalf("New() is \"%+v\", want nil.", err)
+			}
+			r = r.WithTrim(tc.mode)
+			if got := readAll(t, r); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("WithTrim(%v) reads %q, want %q.", tc.mode, got, tc.want)
+			}
+			if pos := r.Position(0); pos.Cell != "A4" {
+				t.Errorf("Position() is %+v, want %v.", pos, "A4")
+			}
+			_ = r.Close()
+		}
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
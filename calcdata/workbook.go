@@ -0,0 +1,90 @@
+package calcdata
+
+import (
+	"bytes"
+	"iter"
+
+	"github.com/goark/csvdata"
+	"github.com/goark/errs"
+	"github.com/knieriem/odf/ods"
+)
+
+// Workbook is class of LibreOffice Calc document for reading all sheets.
+type Workbook struct {
+	doc *ods.Doc
+}
+
+var _ csvdata.Workbook = (*Workbook)(nil) //Workbook is compatible with csvdata.Workbook interface
+
+// NewWorkbook function creates a new Workbook instance.
+func NewWorkbook(doc *ods.Doc) *Workbook {
+	return &Workbook{doc: doc}
+}
+
+// Sheets method returns metadata of sheets in document. Visible is false if table style has table:display="false" attribute.
+func (w *Workbook) Sheets() ([]csvdata.SheetInfo, error) {
+	if w == nil || w.doc == nil {
+		return nil, errs.Wrap(csvdata.ErrNullPointer)
+	}
+	list := make([]csvdata.SheetInfo, 0, len(w.doc.Table))
+	for i := range w.doc.Table {
+		table := tableOf(w.doc, i)
+		info := csvdata.SheetInfo{Name: table.name, Index: i, Visible: !table.hidden}
+		info.Rows, info.Columns = tableSize(table)
+		list = append(list, info)
+	}
+	return list, nil
+}
+
+// OpenSheet method returns Reader instance for the sheet. It is the same as New function.
+func (w *Workbook) OpenSheet(name string) (csvdata.RowsReader, error) {
+	if w == nil {
+		return nil, errs.Wrap(csvdata.ErrNullPointer)
+	}
+	r, err := New(w.doc, name)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return r, nil
+}
+
+// All method returns iterator over all sheets in document. (see csvdata.AllSheets function)
+func (w *Workbook) All(headerFlag bool) iter.Seq2[*csvdata.SheetRows, error] {
+	return csvdata.AllSheets(w, headerFlag)
+}
+
+// tableSize function returns number of rows and columns in used range of table.
+func tableSize(table *typedTable) (int, int) {
+	buf := &bytes.Buffer{}
+	rows, cols, n := 0, 0, 0
+	for i := range table.rows {
+		row := &table.rows[i]
+		repeat := row.RepeatedRows
+		if repeat < 1 {
+			repeat = 1
+		}
+		n += repeat
+		if width := len(row.strings(buf)); width > 0 {
+			rows = n
+			if cols < width {
+				cols = width
+			}
+		}
+	}
+	return rows, cols
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,102 @@
+package calcdata_test
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/goark/csvdata"
+	"github.com/goark/csvdata/calcdata"
+)
+
+func TestWorkbook(t *testing.T) {
+	doc, err := calcdata.OpenFile("testdata/sample.ods")
+	if err != nil {
+		t.Fatalf("OpenFile() is \"%+v\", want nil.", err)
+	}
+	wb := calcdata.NewWorkbook(doc)
+	sheets, err := wb.Sheets()
+	if err != nil {
+		t.Fatalf("Sheets() is \"%+v\", want nil.", err)
+	}
+	if len(sheets) == 0 || sheets[0].Index != 0 || sheets[0].Rows != 5 || sheets[0].Columns != 5 || !sheets[0].Visible {
+		t.Errorf("Sheets() is %+v.", sheets)
+	}
+	names := []string{}
+	for sheet, err := range wb.All(true) {
+		if err != nil {
+			t.Fatalf("All() yields \"%+v\", want nil.", err)
+		}
+		if sheet.Index != 0 {
+			break
+		}
+		for record, err := range sheet.Records() {
+			if err != nil {
+				t.Fatalf("Records() yields \"%+v\", want nil.", err)
+			}
+			if len(record) > 0 {
+				names = append(names, sheet.Name+":"+record[0])
+			}
+		}
+	}
+	want := []string{sheets[0].Name + ":1", sheets[0].Name + ":2", sheets[0].Name + ":3", sheets[0].Name + ":4"}
+	if !reflect.DeepEqual(names, want) {
+		t.Errorf("All() reads %q, want %q.", names, want)
+	}
+}
+
+const hiddenContent = `<?xml version="1.0" encoding="UTF-8"?>
+<office:document-content xmlns:office="urn:oasis:names:tc:opendocument:xmlns:office:1.0" xmlns:style="urn:oasis:names:tc:opendocument:xmlns:style:1.0" xmlns:table="urn:oasis:names:tc:opendocument:xmlns:table:1.0" xmlns:text="urn:oasis:names:tc:opendocument:xmlns:text:1.0">
+<office:automatic-styles>
+<style:style style:name="ta1" style:family="table"><style:table-properties table:display="true"/></style:style>
+<style:style style:name="ta2" style:family="table"><style:table-properties table:display="false"/></style:style>
+</office:automatic-styles>
+<office:body><office:spreadsheet>
+<table:table table:name="Jan" table:style-name="ta1"><table:table-row><table:table-cell><text:p>1</text:p></table:table-cell></table:table-row></table:table>
+<table:table table:name="Feb" table:style-name="ta2"><table:table-row><table:table-cell><text:p>2</text:p></table:table-cell></table:table-row></table:table>
+<table:table table:name="Mar"><table:table-row><table:table-cell><text:p>3</text:p></table:table-cell></table:table-row></table:table>
+</office:spreadsheet></office:body>
+</office:document-content>`
+
+func TestWorkbookVisible(t *testing.T) {
+	data := odsData(t, hiddenContent)
+	doc, err := calcdata.OpenReaderAt(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("OpenReaderAt() is \"%+v\", want nil.", err)
+	}
+	sheets, err := calcdata.NewWorkbook(doc).Sheets()
+	if err != nil {
+		t.Fatalf("Sheets() is \"%+v\", want nil.", err)
+	}
+	want := []csvdata.SheetInfo{
+		{Name: "Jan", Index: 0, Visible: true, Rows: 1, Columns: 1},
+		{Name: "Feb", Index: 1, Visible: false, Rows: 1, Columns: 1},
+		{Name: "Mar", Index: 2, Visible: true, Rows: 1, Columns: 1},
+	}
+	if !reflect.DeepEqual(sheets, want) {
+		t.Errorf("Sheets() is %+v, want %+v.", sheets, want)
+	}
+}
+
+func TestAllSheetsNil(t *testing.T) {
+	for _, err := range csvdata.AllSheets(nil, true) {
+		if err == nil {
+			t.Error("AllSheets() yields nil, want error.")
+		}
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,186 @@
+package calcdata
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/xml"
+	"hash/crc32"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/goark/csvdata"
+	"github.com/goark/errs"
+)
+
+const (
+	mimeType    = "application/vnd.oasis.opendocument.spreadsheet"
+	manifestXML = `<?xml version="1.0" encoding="UTF-8"?>
+<manifest:manifest xmlns:manifest="urn:oasis:names:tc:opendocument:xmlns:manifest:1.0" manifest:version="1.2">
+ <manifest:file-entry manifest:full-path="/" manifest:version="1.2" manifest:media-type="` + mimeType + `"/>
+ <manifest:file-entry manifest:full-path="content.xml" manifest:media-type="text/xml"/>
+</manifest:manifest>
+`
+	contentHead = `<?xml version="1.0" encoding="UTF-8"?>
+<office:document-content xmlns:office="urn:oasis:names:tc:opendocument:xmlns:office:1.0" xmlns:table="urn:oasis:names:tc:opendocument:xmlns:table:1.0" xmlns:text="urn:oasis:names:tc:opendocument:xmlns:text:1.0" office:version="1.2"><office:body><office:spreadsheet>`
+	contentTail = `</table:table></office:spreadsheet></office:body></office:document-content>`
+)
+
+// Writer is class of LibreOffice Calc data writer.
+// Rows are streamed into OpenDocument Spreadsheet file with single sheet.
+type Writer struct {
+	sheet   string
+	zw      *zip.Writer
+	content *bufio.Writer
+	closer  func() error
+}
+
+var _ csvdata.RowsWriter = (*Writer)(nil) //Writer is compatible with csvdata.RowsWriter interface
+
+// NewWriter function creates a new Writer instance.
+// All cells are written as string values, so that these are read by Reader as is.
+func NewWriter(w io.Writer, sheetName string) *Writer {
+	if len(sheetName) == 0 {
+		sheetName = "Sheet1"
+	}
+	closer := func() error { return nil }
+	if c, ok := w.(io.Closer); ok {
+		closer = c.Close
+	}
+	return &Writer{sheet: sheetName, zw: zip.NewWriter(w), closer: closer}
+}
+
+// Write method writes a record to next row.
+func (w *Writer) Write(record []string) error {
+	if w == nil || w.zw == nil {
+		return errs.Wrap(csvdata.ErrNullPointer)
+	}
+	if err := w.start(); err != nil {
+		return errs.Wrap(err)
+	}
+	b := &strings.Builder{}
+	b.WriteString("<table:table-row>")
+	for _, s := range record {
+		if len(s) == 0 {
+			b.WriteString("<table:table-cell/>")
+			continue
+		}
+		b.WriteString(`<table:table-cell office:value-type="string">`)
+		for _, p := range strings.Split(s, "\n") {
+			b.WriteString("<text:p>")
+			writeText(b, strings.TrimSuffix(p, "\r"))
+			b.WriteString("</text:p>")
+		}
+		b.WriteString("</table:table-cell>")
+	}
+	b.WriteString("</table:table-row>\n")
+	_, err := w.content.WriteString(b.String())
+	return errs.Wrap(err)
+}
+
+// Close method completes OpenDocument Spreadsheet file, and closes the underlying io.Writer if it is io.Closer.
+func (w *Writer) Close() error {
+	if w == nil || w.zw == nil {
+		return nil
+	}
+	err := w.finish()
+	if errc := w.closer(); err == nil {
+		err = errc
+	}
+	w.zw = nil
+	return errs.Wrap(err)
+}
+
+func (w *Writer) start() error {
+	if w.content != nil {
+		return nil
+	}
+	// mimetype entry must be first and uncompressed
+	mw, err := w.zw.CreateRaw(&zip.FileHeader{
+		Name:               "mimetype",
+		Method:             zip.Store,
+		CRC32:              crc32.ChecksumIEEE([]byte(mimeType)),
+		CompressedSize64:   uint64(len(mimeType)),
+		UncompressedSize64: uint64(len(mimeType)),
+	})
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	if _, err := io.WriteString(mw, mimeType); err != nil {
+		return errs.Wrap(err)
+	}
+	fw, err := w.zw.Create("META-INF/manifest.xml")
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	if _, err := io.WriteString(fw, manifestXML); err != nil {
+		return errs.Wrap(err)
+	}
+	cw, err := w.zw.Create("content.xml")
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	w.content = bufio.NewWriter(cw)
+	b := &strings.Builder{}
+	b.WriteString(contentHead)
+	b.WriteString(`<table:table table:name="`)
+	_ = xml.EscapeText(b, []byte(w.sheet))
+	b.WriteString(`"><table:table-column/>` + "\n")
+	_, err = w.content.WriteString(b.String())
+	return errs.Wrap(err)
+}
+
+func (w *Writer) finish() error {
+	if err := w.start(); err != nil {
+		return errs.Wrap(err)
+	}
+	if _, err := w.content.WriteString(contentTail); err != nil {
+		return errs.Wrap(err)
+	}
+	if err := w.content.Flush(); err != nil {
+		return errs.Wrap(err)
+	}
+	return errs.Wrap(w.zw.Close())
+}
+
+// writeText writes text in paragraph. Leading, trailing and sequence of spaces are written by <text:s/> element.
+func writeText(b *strings.Builder, s string) {
+	head := true
+	for len(s) > 0 {
+		i := strings.IndexByte(s, ' ')
+		if i < 0 {
+			i = len(s)
+		}
+		_ = xml.EscapeText(b, []byte(s[:i]))
+		head = head && i == 0
+		s = s[i:]
+		n := len(s) - len(strings.TrimLeft(s, " "))
+		s = s[n:]
+		if n > 0 && !head && len(s) > 0 {
+			b.WriteByte(' ')
+			n--
+		}
+		head = false
+		switch {
+		case n == 1:
+			b.WriteString("<text:s/>")
+		case n > 1:
+			b.WriteString(`<text:s text:c="` + strconv.Itoa(n) + `"/>`)
+		}
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,80 @@
+package csvdata
+
+import "time"
+
+// CellType is type of cell value in spreadsheet.
+type CellType int
+
+const (
+	CellEmpty  CellType = iota // empty cell
+	CellString                 // string value
+	CellNumber                 // numeric value
+	CellDate                   // date/time value (formatted as date in spreadsheet)
+	CellBool                   // boolean value
+	CellError                  // error value (e.g. "#DIV/0!")
+)
+
+var cellTypeNames = map[CellType]string{
+	CellEmpty:  "empty",
+	CellString: "string",
+	CellNumber: "number",
+	CellDate:   "date",
+	CellBool:   "bool",
+	CellError:  "error",
+}
+
+// String method is a implementation of fmt.Stringer interface.
+func (t CellType) String() string {
+	if s, ok := cellTypeNames[t]; ok {
+		return s
+	}
+	return "unknown"
+}
+
+// Cell is typed value of cell in spreadsheet.
+type Cell struct {
+	Type    CellType
+	Value   string    // raw value of cell (e.g. serial number of date)
+	Formula string    // formula of cell (empty if cell has no formula; value is the result)
+	Number  float64   // value of CellNumber, or serial number of CellDate
+	Bool    bool      // value of CellBool
+	Time    time.Time // value of CellDate
+}
+
+// TypedReader is optional interface for RowsReader to provide typed value of a field in the last read record.
+// Rows.GetXxx methods use the value instead of parsing string if ok is true.
+type TypedReader interface {
+	Cell(field int) (c Cell, ok bool)
+}
+
+// Cell method returns typed value of i-th field in current row if RowsReader provides it (see TypedReader interface).
+func (r *Rows) Cell(i int) (Cell, bool) {
+	return r.cell(i)
+}
+
+// cell method returns typed value of field in current row if reader provides it.
+func (r *Rows) cell(i int) (Cell, bool) {
+	if r == nil || r.single {
+		return Cell{}, false
+	}
+	tr, ok := r.reader.(TypedReader)
+	if !ok {
+		return Cell{}, false
+	}
+	return tr.Cell(i)
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -4,18 +4,29 @@ import (
 	"encoding/csv"
 	"io"
 	"os"
+	"strings"
 
 	"github.com/goark/errs"
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/unicode"
+	"golang.org/x/text/transform"
 )
 
 // Reader is class of CSV reader
 type Reader struct {
 	trimSpace bool
 	reader    *csv.Reader
+	source    *sourceReader
+	fields    int
+	raw       string
 	closer    func() error
 }
 
-var _ RowsReader = (*Reader)(nil) //Reader is compatible with RowsReader interface
+var (
+	_ RowsReader     = (*Reader)(nil) //Reader is compatible with RowsReader interface
+	_ PositionReader = (*Reader)(nil) //Reader is compatible with PositionReader interface
+	_ RawReader      = (*Reader)(nil) //Reader is compatible with RawReader interface
+)
 
 // OpenFile returns CSV file Reader.
 func OpenFile(path string) (*os.File, error) {
@@ -27,8 +38,10 @@ func OpenFile(path string) (*os.File, error) {
 }
 
 // New function creates a new Reader instance.
+// BOM at the beginning of input is removed, and UTF-16 data with BOM is decoded automatically.
 func New(r io.Reader) *Reader {
-	cr := csv.NewReader(r)
+	src := &sourceReader{source: r}
+	cr := csv.NewReader(src)
 	cr.Comma = ','
 	cr.LazyQuotes = true       // a quote may appear in an unquoted field and a non-doubled quote may appear in a quoted field.
 	cr.TrimLeadingSpace = true // leading
@@ -36,7 +49,7 @@ func New(r io.Reader) *Reader {
 	if c, ok := r.(io.Closer); ok {
 		closer = c.Close
 	}
-	return &Reader{reader: cr, closer: closer}
+	return &Reader{reader: cr, source: src, closer: closer}
 }
 
 // TrimSpace returns TrimSpace option.
@@ -58,6 +71,16 @@ func (r *Reader) WithComma(c rune) *Reader {
 	return r
 }
 
+// WithEncoding method sets character encoding of input data (e.g. japanese.ShiftJIS in golang.org/x/text/encoding/japanese package).
+// BOM in input data takes precedence over this setting. This method must be called before reading.
+func (r *Reader) WithEncoding(enc encoding.Encoding) *Reader {
+	if r == nil {
+		return nil
+	}
+	r.source.enc = enc
+	return r
+}
+
 // WithTrimSpace method sets trimSpace and TrimLeadingSpace property.
 func (r *Reader) WithTrimSpace(mode bool) *Reader {
 	if r == nil {
@@ -100,16 +123,63 @@ func (r *Reader) Read() ([]string, error) {
 	if r == nil {
 		return nil, errs.Wrap(ErrNullPointer)
 	}
+	start := r.reader.InputOffset()
 	elms, err := r.reader.Read()
+	r.fields = len(elms)
+	if r.source.keep {
+		r.raw = r.source.cut(start, r.reader.InputOffset())
+	}
 	if err != nil {
 		if errs.Is(err, io.EOF) {
 			return nil, errs.Wrap(err)
 		}
-		return nil, errs.Wrap(ErrInvalidRecord, errs.WithCause(err))
+		fe := &FieldError{Index: -1, Err: errs.Wrap(ErrInvalidRecord, errs.WithCause(err))}
+		var perr *csv.ParseError
+		if errs.As(err, &perr) {
+			fe.Line, fe.Column = perr.Line, perr.Column
+		}
+		return nil, errs.Wrap(fe, errs.WithContext("line", fe.Line), errs.WithContext("column", fe.Column))
 	}
 	return elms, nil
 }
 
+// WithRaw method sets mode for keeping raw text of records (see Raw method). Rows.WithLenient method enables this mode.
+// This method should be called before reading, because raw text of data buffered before the call is not available.
+func (r *Reader) WithRaw(mode bool) *Reader {
+	if r == nil {
+		return nil
+	}
+	if mode != r.source.keep {
+		r.source.buf, r.source.head = r.source.buf[:0], 0
+		r.source.base = r.source.total // start of data to be kept
+		r.raw = ""
+	}
+	r.source.keep = mode
+	return r
+}
+
+// Raw method returns raw text of the last read record if raw mode is enabled by WithRaw method.
+func (r *Reader) Raw() string {
+	if r == nil {
+		return ""
+	}
+	return r.raw
+}
+
+// Position method returns position of field in the last read record.
+func (r *Reader) Position(field int) Position {
+	if r == nil || r.fields == 0 || field < 0 {
+		return Position{}
+	}
+	if field >= r.fields {
+		line, _ := r.reader.FieldPos(r.fields - 1)
+		return Position{Line: line}
+	}
+	line, column := r.reader.FieldPos(field)
+	return Position{Line: line, Column: column}
+}
+
+// Close method closes the underlying io.Reader if it is io.Closer.
 func (r *Reader) Close() error {
 	if r == nil || r.closer == nil {
 		return nil
@@ -117,6 +187,55 @@ func (r *Reader) Close() error {
 	return r.closer()
 }
 
+// sourceReader is io.Reader that decodes input data by character encoding and removes BOM.
+// In raw mode, decoded data is kept until it is cut out as raw text of record.
+type sourceReader struct {
+	source io.Reader
+	enc    encoding.Encoding
+	reader io.Reader
+	keep   bool // raw mode
+	buf    []byte
+	head   int   // length of discarded data in buf
+	base   int64 // offset of buf[0] in decoded data
+	total  int64 // length of decoded data
+}
+
+func (s *sourceReader) Read(p []byte) (int, error) {
+	if s.reader == nil {
+		dec := encoding.Nop.NewDecoder()
+		if s.enc != nil {
+			dec = s.enc.NewDecoder()
+		}
+		s.reader = transform.NewReader(s.source, unicode.BOMOverride(dec))
+	}
+	n, err := s.reader.Read(p)
+	s.total += int64(n)
+	if s.keep {
+		if s.head > 0 && s.head >= len(s.buf)/2 {
+			// discard data before head
+			s.buf = s.buf[:copy(s.buf, s.buf[s.head:])]
+			s.base += int64(s.head)
+			s.head = 0
+		}
+		s.buf = append(s.buf, p[:n]...)
+	}
+	return n, err
+}
+
+// cut returns text from start to end offset in decoded data, and discards data before end offset.
+func (s *sourceReader) cut(start, end int64) string {
+	from, to := int(start-s.base), int(end-s.base)
+	if to < s.head || to > len(s.buf) {
+		return ""
+	}
+	text := ""
+	if from >= s.head && from <= to {
+		text = strings.TrimRight(string(s.buf[from:to]), "\r\n")
+	}
+	s.head = to
+	return text
+}
+
 /* Copyright 2021 Spiegel
  *
  * Licensed under the Apache License, Version 2.0 (the "License");
@@ -0,0 +1,132 @@
+package csvdata
+
+import (
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/goark/errs"
+)
+
+// LayoutSerial is special layout for Rows.GetTime method to parse serial number of date in spreadsheet (e.g. "45000.5").
+// The serial number is converted by DateSystem of RowsReader (DateSystem1900 by default).
+const LayoutSerial = "serial"
+
+// DateSystem is date system of serial number in spreadsheet.
+type DateSystem int
+
+const (
+	DateSystem1900 DateSystem = iota // Excel 1900 date system: 1 is 1900-01-01 and 60 is fictitious 1900-02-29 (leap year bug)
+	DateSystem1904                   // Excel 1904 date system: 0 is 1904-01-01
+	DateSystemODF                    // OpenDocument: 0 is 1899-12-30
+)
+
+// DateSystemReader is optional interface for RowsReader to report date system of serial number.
+type DateSystemReader interface {
+	DateSystem() DateSystem
+}
+
+// SerialToTime function converts serial number of date in spreadsheet to time.Time (UTC). Time of day is rounded to microseconds.
+func SerialToTime(serial float64, ds DateSystem) (time.Time, error) {
+	if math.IsNaN(serial) || math.IsInf(serial, 0) || math.Abs(serial) > 2958466 { // after 9999-12-31
+		return time.Time{}, errs.Wrap(ErrInvalidSerialDate, errs.WithContext("serial", serial))
+	}
+	var base time.Time
+	switch ds {
+	case DateSystem1904:
+		if serial < 0 {
+			return time.Time{}, errs.Wrap(ErrInvalidSerialDate, errs.WithContext("serial", serial))
+		}
+		base = time.Date(1904, time.January, 1, 0, 0, 0, 0, time.UTC)
+	case DateSystemODF:
+		base = time.Date(1899, time.December, 30, 0, 0, 0, 0, time.UTC)
+	default:
+		switch {
+		case serial < 0 || (serial >= 60 && serial < 61):
+			return time.Time{}, errs.Wrap(ErrInvalidSerialDate, errs.WithContext("serial", serial))
+		case serial < 60:
+			base = time.Date(1899, time.December, 31, 0, 0, 0, 0, time.UTC)
+		default:
+			base = time.Date(1899, time.December, 30, 0, 0, 0, 0, time.UTC)
+		}
+	}
+	days := math.Floor(serial)
+	usec := math.Round((serial - days) * 86400e6)
+	return base.AddDate(0, 0, int(days)).Add(time.Duration(usec) * time.Microsecond), nil
+}
+
+// TimeToSerial function converts time to serial number of date in spreadsheet. Time zone of tm is ignored (wall clock is used).
+func TimeToSerial(tm time.Time, ds DateSystem) (float64, error) {
+	y, m, d := tm.Date()
+	date := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	clock := tm.Sub(time.Date(y, m, d, 0, 0, 0, 0, tm.Location())).Hours() / 24
+	var base time.Time
+	switch ds {
+	case DateSystem1904:
+		base = time.Date(1904, time.January, 1, 0, 0, 0, 0, time.UTC)
+	default:
+		base = time.Date(1899, time.December, 30, 0, 0, 0, 0, time.UTC)
+	}
+	days := float64((date.Unix() - base.Unix()) / 86400)
+	if ds == DateSystem1900 && days < 61 {
+		days-- // before fictitious 1900-02-29
+	}
+	if (ds == DateSystem1900 || ds == DateSystem1904) && days < 0 {
+		return 0, errs.Wrap(ErrInvalidSerialDate, errs.WithContext("time", tm.Format(time.RFC3339)))
+	}
+	return days + clock, nil
+}
+
+// WithDateSystem method sets date system of serial number for LayoutSerial. It overrides DateSystem of RowsReader.
+func (r *Rows) WithDateSystem(ds DateSystem) *Rows {
+	if r == nil {
+		return nil
+	}
+	r.dateSystem = &ds
+	return r
+}
+
+// DateSystem method returns date system of serial number for LayoutSerial.
+func (r *Rows) DateSystem() DateSystem {
+	if r == nil {
+		return DateSystem1900
+	}
+	if r.dateSystem != nil {
+		return *r.dateSystem
+	}
+	if dr, ok := r.reader.(DateSystemReader); ok {
+		return dr.DateSystem()
+	}
+	return DateSystem1900
+}
+
+// serialTime method parses s as serial number of date in i-th field.
+func (r *Rows) serialTime(i int, s string) (time.Time, error) {
+	serial, err := strconv.ParseFloat(s, 64)
+	if c, ok := r.cell(i); ok && (c.Type == CellNumber || c.Type == CellDate) {
+		serial, err = c.Number, nil
+	}
+	if err != nil {
+		return time.Time{}, r.fieldError(i, err)
+	}
+	tm, err := SerialToTime(serial, r.DateSystem())
+	if err != nil {
+		return time.Time{}, r.fieldError(i, err)
+	}
+	return tm, nil
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,113 @@
+package csvdata_test
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/goark/csvdata"
+)
+
+func TestSerialToTime(t *testing.T) {
+	testCases := []struct {
+		serial float64
+		ds     csvdata.DateSystem
+		want   time.Time
+		err    error
+	}{
+		{serial: 1, ds: csvdata.DateSystem1900, want: time.Date(1900, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		{serial: 59, ds: csvdata.DateSystem1900, want: time.Date(1900, time.February, 28, 0, 0, 0, 0, time.UTC)},
+		{serial: 60, ds: csvdata.DateSystem1900, err: csvdata.ErrInvalidSerialDate},
+		{serial: 61, ds: csvdata.DateSystem1900, want: time.Date(1900, time.March, 1, 0, 0, 0, 0, time.UTC)},
+		{serial: 45000.5, ds: csvdata.DateSystem1900, want: time.Date(2023, time.March, 15, 12, 0, 0, 0, time.UTC)},
+		{serial: 45000 + 1.0/3, ds: csvdata.DateSystem1900, want: time.Date(2023, time.March, 15, 8, 0, 0, 0, time.UTC)},
+		{serial: -1, ds: csvdata.DateSystem1900, err: csvdata.ErrInvalidSerialDate},
+		{serial: 0, ds: csvdata.DateSystem1904, want: time.Date(1904, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		{serial: 43538.5, ds: csvdata.DateSystem1904, want: time.Date(2023, time.March, 15, 12, 0, 0, 0, time.UTC)},
+		{serial: 0, ds: csvdata.DateSystemODF, want: time.Date(1899, time.December, 30, 0, 0, 0, 0, time.UTC)},
+		{serial: 60, ds: csvdata.DateSystemODF, want: time.Date(1900, time.February, 28, 0, 0, 0, 0, time.UTC)},
+		{serial: 45000.5, ds: csvdata.DateSystemODF, want: time.Date(2023, time.March, 15, 12, 0, 0, 0, time.UTC)},
+		{serial: -1, ds: csvdata.DateSystemODF, want: time.Date(1899, time.December, 29, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tc := range testCases {
+		tm, err := csvdata.SerialToTime(tc.serial, tc.ds)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("SerialToTime(%v, %v) is \"%+v\", want \"%+v\".", tc.serial, tc.ds, err, tc.err)
+		} else if !tm.Equal(tc.want) {
+			t.Errorf("SerialToTime(%v, %v) is %v, want %v.", tc.serial, tc.ds, tm, tc.want)
+		}
+	}
+}
+
+func TestTimeToSerial(t *testing.T) {
+	testCases := []struct {
+		tm   time.Time
+		ds   csvdata.DateSystem
+		want float64
+		err  error
+	}{
+		{tm: time.Date(1900, time.January, 1, 0, 0, 0, 0, time.UTC), ds: csvdata.DateSystem1900, want: 1},
+		{tm: time.Date(1900, time.February, 28, 0, 0, 0, 0, time.UTC), ds: csvdata.DateSystem1900, want: 59},
+		{tm: time.Date(1900, time.March, 1, 0, 0, 0, 0, time.UTC), ds: csvdata.DateSystem1900, want: 61},
+		{tm: time.Date(2023, time.March, 15, 12, 0, 0, 0, time.UTC), ds: csvdata.DateSystem1900, want: 45000.5},
+		{tm: time.Date(2023, time.March, 15, 12, 0, 0, 0, time.FixedZone("JST", 9*3600)), ds: csvdata.DateSystem1900, want: 45000.5},
+		{tm: time.Date(1899, time.December, 30, 0, 0, 0, 0, time.UTC), ds: csvdata.DateSystem1900, err: csvdata.ErrInvalidSerialDate},
+		{tm: time.Date(2023, time.March, 15, 12, 0, 0, 0, time.UTC), ds: csvdata.DateSystem1904, want: 43538.5},
+		{tm: time.Date(1903, time.December, 31, 0, 0, 0, 0, time.UTC), ds: csvdata.DateSystem1904, err: csvdata.ErrInvalidSerialDate},
+		{tm: time.Date(1899, time.December, 29, 0, 0, 0, 0, time.UTC), ds: csvdata.DateSystemODF, want: -1},
+		{tm: time.Date(9999, time.December, 31, 0, 0, 0, 0, time.UTC), ds: csvdata.DateSystemODF, want: 2958465},
+	}
+	for _, tc := range testCases {
+		f, err := csvdata.TimeToSerial(tc.tm, tc.ds)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("TimeToSerial(%v, %v) is \"%+v\", want \"%+v\".", tc.tm, tc.ds, err, tc.err)
+		} else if f != tc.want {
+			t.Errorf("TimeToSerial(%v, %v) is %v, want %v.", tc.tm, tc.ds, f, tc.want)
+		}
+	}
+}
+
+func TestLayoutSerial(t *testing.T) {
+	testCases := []struct {
+		ds   *csvdata.DateSystem
+		want time.Time
+	}{
+		{ds: nil, want: time.Date(2023, time.March, 15, 12, 0, 0, 0, time.UTC)},
+		{ds: func(ds csvdata.DateSystem) *csvdata.DateSystem { return &ds }(csvdata.DateSystem1904), want: time.Date(2027, time.March, 16, 12, 0, 0, 0, time.UTC)},
+	}
+	for _, tc := range testCases {
+		rc := csvdata.NewRows(csvdata.New(strings.NewReader("date\n45000.5\nfoo\n")), true)
+		if tc.ds != nil {
+			rc = rc.WithDateSystem(*tc.ds)
+		}
+		if err := rc.Next(); err != nil {
+			t.Fatalf("Next() is \"%+v\", want nil.", err)
+		}
+		if tm, err := rc.ColumnTime("date", csvdata.LayoutSerial); err != nil || !tm.Equal(tc.want) {
+			t.Errorf("ColumnTime() is %v, \"%+v\", want %v.", tm, err, tc.want)
+		}
+		if err := rc.Next(); err != nil {
+			t.Fatalf("Next() is \"%+v\", want nil.", err)
+		}
+		if _, err := rc.ColumnTime("date", csvdata.LayoutSerial); !errors.Is(err, strconv.ErrSyntax) {
+			t.Errorf("ColumnTime() is \"%+v\", want \"%+v\".", err, strconv.ErrSyntax)
+		}
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,395 @@
+package csvdata
+
+import (
+	"database/sql"
+	"encoding"
+	"io"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/goark/errs"
+)
+
+// TagName is a key of struct tag for decoding and encoding.
+//
+//	type Planet struct {
+//		Order     int       `csv:"order,required"`
+//		Name      string    `csv:"name"`
+//		Mass      float64   `csv:"mass,default=0"`
+//		Flags     uint8     `csv:"flags,base=16"`
+//		Discovery time.Time `csv:"discovery,layout=2006-01-02"`
+//		Note      string    `csv:"-"`
+//	}
+//
+// The first element is a column name (field name if empty). "-" skips the field.
+// Options are follows:
+//
+//	base=N     base of integer (default 10)
+//	layout=S   layout of time.Time (default time.RFC3339)
+//	required   error if the column is missing or the value is empty
+//	omitempty  empty value is written for zero value (encoding only)
+//	default=S  value used instead of an empty cell
+//
+// The value of layout or default option may contain commas.
+const TagName = "csv"
+
+type fieldInfo struct {
+	index      []int
+	field      string
+	name       string
+	base       int
+	layout     string
+	required   bool
+	omitEmpty  bool
+	defValue   string
+	hasDefault bool
+}
+
+type structInfo struct {
+	fields []fieldInfo
+	err    error
+}
+
+var structCache sync.Map // map[reflect.Type]*structInfo
+
+// Decode method stores current row data into the struct pointed to by v.
+// Columns are associated with struct fields by TagName struct tag.
+func (r *Rows) Decode(v any) error {
+	if r == nil {
+		return errs.Wrap(ErrNullPointer)
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return errs.Wrap(ErrUnsupportedType, errs.WithContext("type", reflect.TypeOf(v)))
+	}
+	rv = rv.Elem()
+	si := getStructInfo(rv.Type())
+	if si.err != nil {
+		return errs.Wrap(si.err)
+	}
+	for i := range si.fields {
+		fi := &si.fields[i]
+		if err := r.decodeField(rv.FieldByIndex(fi.index), fi); err != nil {
+			return errs.Wrap(err, errs.WithContext("field", fi.field), errs.WithContext("column", fi.name))
+		}
+	}
+	return nil
+}
+
+// DecodeAll function reads all remaining rows and returns them as slice of T.
+// T must be struct type.
+func DecodeAll[T any](r *Rows) ([]T, error) {
+	list := []T{}
+	for {
+		if err := r.Next(); err != nil {
+			if errs.Is(err, io.EOF) {
+				break
+			}
+			return list, errs.Wrap(err)
+		}
+		var v T
+		if err := r.Decode(&v); err != nil {
+			return list, errs.Wrap(err)
+		}
+		list = append(list, v)
+	}
+	return list, nil
+}
+
+func (r *Rows) decodeField(fv reflect.Value, fi *fieldInfo) error {
+	i, err := r.indexOf(fi.name)
+	if err != nil {
+		if fi.required {
+			return errs.Wrap(err)
+		}
+		return nil
+	}
+	src := r
+	if fi.hasDefault && r.isNull(i) {
+		src, i = r.valueRows(fi.defValue), 0
+	}
+	if err := src.setValue(fv, i, fi); err != nil {
+		if errs.Is(err, ErrNullValue) && !fi.required {
+			return nil
+		}
+		return errs.Wrap(err)
+	}
+	return nil
+}
+
+func (r *Rows) isNull(i int) bool {
+	s, err := r.GetString(i)
+	return err != nil || len(strings.TrimSpace(s)) == 0
+}
+
+// valueRows returns Rows instance with single value for parsing s in the same rules.
+func (r *Rows) valueRows(s string) *Rows {
+	return &Rows{reader: r.reader, headerMap: map[string]int{}, rowdata: []string{s}, single: true, dateSystem: r.dateSystem}
+}
+
+func (r *Rows) setValue(fv reflect.Value, i int, fi *fieldInfo) error {
+	switch p := fv.Addr().Interface().(type) {
+	case *time.Time:
+		tm, err := r.GetTime(i, fi.layout)
+		if err != nil {
+			return errs.Wrap(err)
+		}
+		*p = tm
+		return nil
+	case *sql.NullString:
+		s, err := r.GetString(i)
+		if err != nil && !errs.Is(err, ErrNullValue) {
+			return errs.Wrap(err)
+		}
+		*p = sql.NullString{String: s, Valid: len(s) > 0}
+		if !p.Valid {
+			return errs.Wrap(ErrNullValue)
+		}
+		return nil
+	case *sql.NullBool:
+		b, err := r.GetBool(i)
+		*p = sql.NullBool{Bool: b, Valid: err == nil}
+		return errs.Wrap(err)
+	case *sql.NullFloat64:
+		f, err := r.GetFloat64(i)
+		*p = sql.NullFloat64{Float64: f, Valid: err == nil}
+		return errs.Wrap(err)
+	case *sql.NullInt64:
+		n, err := r.GetInt64(i, fi.base)
+		*p = sql.NullInt64{Int64: n, Valid: err == nil}
+		return errs.Wrap(err)
+	case *sql.NullInt32:
+		n, err := r.getIntRange(i, fi.base, math.MinInt32, math.MaxInt32)
+		*p = sql.NullInt32{Int32: int32(n), Valid: err == nil}
+		return errs.Wrap(err)
+	case *sql.NullInt16:
+		n, err := r.getIntRange(i, fi.base, math.MinInt16, math.MaxInt16)
+		*p = sql.NullInt16{Int16: int16(n), Valid: err == nil}
+		return errs.Wrap(err)
+	case *sql.NullByte:
+		n, err := r.getIntRange(i, fi.base, 0, math.MaxUint8)
+		*p = sql.NullByte{Byte: byte(n), Valid: err == nil}
+		return errs.Wrap(err)
+	case *sql.NullTime:
+		tm, err := r.GetTime(i, fi.layout)
+		*p = sql.NullTime{Time: tm, Valid: err == nil}
+		return errs.Wrap(err)
+	case encoding.TextUnmarshaler:
+		if r.isNull(i) {
+			return errs.Wrap(ErrNullValue)
+		}
+		s, err := r.GetString(i)
+		if err != nil {
+			return errs.Wrap(err)
+		}
+		if err := p.UnmarshalText([]byte(s)); err != nil {
+			return r.fieldError(i, err)
+		}
+		return nil
+	}
+
+	switch fv.Kind() {
+	case reflect.String:
+		s, err := r.GetString(i)
+		if err != nil {
+			return errs.Wrap(err)
+		}
+		fv.SetString(s)
+		if len(s) == 0 {
+			return errs.Wrap(ErrNullValue)
+		}
+		return nil
+	case reflect.Bool:
+		b, err := r.GetBool(i)
+		if err != nil {
+			return errs.Wrap(err)
+		}
+		fv.SetBool(b)
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := r.GetInt64(i, fi.base)
+		if err != nil {
+			return errs.Wrap(err)
+		}
+		if fv.OverflowInt(n) {
+			return r.fieldError(i, strconv.ErrRange)
+		}
+		fv.SetInt(n)
+		return nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := r.getUint64(i, fi.base)
+		if err != nil {
+			return errs.Wrap(err)
+		}
+		if fv.OverflowUint(n) {
+			return r.fieldError(i, strconv.ErrRange)
+		}
+		fv.SetUint(n)
+		return nil
+	case reflect.Float32, reflect.Float64:
+		f, err := r.GetFloat64(i)
+		if err != nil {
+			return errs.Wrap(err)
+		}
+		if fv.OverflowFloat(f) {
+			return r.fieldError(i, strconv.ErrRange)
+		}
+		fv.SetFloat(f)
+		return nil
+	case reflect.Pointer:
+		if r.isNull(i) {
+			fv.Set(reflect.Zero(fv.Type()))
+			return errs.Wrap(ErrNullValue)
+		}
+		pv := reflect.New(fv.Type().Elem())
+		if err := r.setValue(pv.Elem(), i, fi); err != nil {
+			return errs.Wrap(err)
+		}
+		fv.Set(pv)
+		return nil
+	}
+	return errs.Wrap(ErrUnsupportedType, errs.WithContext("type", fv.Type()))
+}
+
+// getUint64 method returns unsigned integer value of i-th field in current row (the same rules as GetInt64 method).
+func (r *Rows) getUint64(i int, base int) (uint64, error) {
+	s, err := r.GetString(i)
+	if err != nil {
+		return 0, errs.Wrap(err)
+	}
+	if !r.LazyQuotes() {
+		s = strings.TrimSpace(s)
+	}
+	if len(s) == 0 {
+		return 0, errs.Wrap(ErrNullValue)
+	}
+	n, err := strconv.ParseUint(strings.TrimPrefix(s, "+"), base, 64)
+	if err != nil {
+		if _, e := strconv.ParseInt(s, base, 64); e == nil { // negative value
+			err = &strconv.NumError{Func: "ParseUint", Num: s, Err: strconv.ErrRange}
+		}
+		return 0, r.fieldError(i, err)
+	}
+	return n, nil
+}
+
+func (r *Rows) getIntRange(i, base int, min, max int64) (int64, error) {
+	n, err := r.GetInt64(i, base)
+	if err != nil {
+		return 0, errs.Wrap(err)
+	}
+	if n < min || n > max {
+		return 0, r.fieldError(i, strconv.ErrRange)
+	}
+	return n, nil
+}
+
+func getStructInfo(t reflect.Type) *structInfo {
+	if si, ok := structCache.Load(t); ok {
+		return si.(*structInfo)
+	}
+	si := &structInfo{}
+	si.fields, si.err = parseStruct(t, nil)
+	v, _ := structCache.LoadOrStore(t, si)
+	return v.(*structInfo)
+}
+
+func parseStruct(t reflect.Type, index []int) ([]fieldInfo, error) {
+	fields := []fieldInfo{}
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		tag, tagged := sf.Tag.Lookup(TagName)
+		if tag == "-" {
+			continue
+		}
+		idx := append(append([]int{}, index...), i)
+		if sf.Anonymous && !tagged && sf.Type.Kind() == reflect.Struct {
+			list, err := parseStruct(sf.Type, idx)
+			if err != nil {
+				return nil, err
+			}
+			fields = append(fields, list...)
+			continue
+		}
+		if !sf.IsExported() {
+			continue
+		}
+		fi, err := parseTag(tag)
+		if err != nil {
+			return nil, errs.Wrap(err, errs.WithContext("field", sf.Name), errs.WithContext("tag", tag))
+		}
+		fi.index = idx
+		fi.field = sf.Name
+		if len(fi.name) == 0 {
+			fi.name = sf.Name
+		}
+		fields = append(fields, fi)
+	}
+	return fields, nil
+}
+
+func parseTag(tag string) (fieldInfo, error) {
+	fi := fieldInfo{base: 10}
+	elms := strings.Split(tag, ",")
+	fi.name = strings.TrimSpace(elms[0])
+	last := ""
+	for _, elm := range elms[1:] {
+		key, value, ok := strings.Cut(elm, "=")
+		switch strings.TrimSpace(key) {
+		case "required":
+			fi.required, last = true, ""
+			continue
+		case "omitempty":
+			fi.omitEmpty, last = true, ""
+			continue
+		case "base":
+			if ok {
+				base, err := strconv.Atoi(strings.TrimSpace(value))
+				if err != nil || base == 1 || base < 0 || base > 36 {
+					return fi, errs.Wrap(ErrInvalidTag, errs.WithCause(err), errs.WithContext("base", value))
+				}
+				fi.base, last = base, ""
+				continue
+			}
+		case "layout":
+			if ok {
+				fi.layout, last = value, "layout"
+				continue
+			}
+		case "default":
+			if ok {
+				fi.defValue, fi.hasDefault, last = value, true, "default"
+				continue
+			}
+		}
+		// comma in the value of layout or default option
+		switch last {
+		case "layout":
+			fi.layout += "," + elm
+		case "default":
+			fi.defValue += "," + elm
+		default:
+			return fi, errs.Wrap(ErrInvalidTag, errs.WithContext("option", elm))
+		}
+	}
+	return fi, nil
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,171 @@
+package csvdata_test
+
+import (
+	"bytes"
+	"database/sql"
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/goark/csvdata"
+)
+
+type planet struct {
+	Order     int             `csv:"order,required"`
+	Name      string          `csv:"name"`
+	Mass      float64         `csv:"mass"`
+	Distance  sql.NullFloat64 `csv:"distance"`
+	Habitable *bool           `csv:"habitable"`
+	Note      time.Time       `csv:"note"`
+	Ignore    string          `csv:"-"`
+}
+
+func TestDecodeAll(t *testing.T) {
+	rc := csvdata.NewRows(csvdata.New(strings.NewReader(csv1)).WithTrimSpace(true), true)
+	defer rc.Close() //dummy
+	list, err := csvdata.DecodeAll[planet](rc)
+	if err != nil {
+		t.Fatalf("DecodeAll() is \"%+v\", want nil.", err)
+	}
+	if len(list) != 4 {
+		t.Fatalf("count of DecodeAll() is %v, want %v.", len(list), 4)
+	}
+	p := list[2]
+	if p.Order != 3 || p.Name != "Earth" || p.Mass != 1.0 || !p.Distance.Valid || p.Distance.Float64 != 1.0 || p.Habitable == nil || !*p.Habitable {
+		t.Errorf("DecodeAll()[2] is %+v, want Earth.", p)
+	}
+	if p.Note.IsZero() {
+		t.Errorf("DecodeAll()[2].Note is zero, want 2006-01-02T15:04:05+09:00.")
+	}
+}
+
+func TestDecodeOptions(t *testing.T) {
+	type data struct {
+		Flags   uint8          `csv:"flags,base=16"`
+		Date    time.Time      `csv:"date,layout=Jan 2, 2006"`
+		Count   int            `csv:"count,default=-1"`
+		Memo    sql.NullString `csv:"memo"`
+		Missing int            `csv:"missing"`
+	}
+	testCases := []struct {
+		inp  string
+		want data
+		err  error
+	}{
+		{inp: "flags,date,count,memo\nff,\"Mar 14, 2023\",,\n", want: data{Flags: 0xff, Date: time.Date(2023, time.March, 14, 0, 0, 0, 0, time.UTC), Count: -1}, err: nil},
+		{inp: "flags,date,count,memo\n1,\"Mar 14, 2023\",7,foo\n", want: data{Flags: 1, Date: time.Date(2023, time.March, 14, 0, 0, 0, 0, time.UTC), Count: 7, Memo: sql.NullString{String: "foo", Valid: true}}, err: nil},
+		{inp: "flags,date,count,memo\n100,,,\n", want: data{}, err: strconv.ErrRange},
+		{inp: "flags,date,count,memo\nxx,,,\n", want: data{}, err: strconv.ErrSyntax},
+	}
+	for _, tc := range testCases {
+		rc := csvdata.NewRows(csvdata.New(strings.NewReader(tc.inp)), true)
+		if err := rc.Next(); err != nil {
+			t.Errorf("Next() is \"%+v\", want nil.", err)
+			continue
+		}
+		var d data
+		err := rc.Decode(&d)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("Decode() is \"%+v\", want \"%+v\".", err, tc.err)
+		}
+		if err == nil && d != tc.want {
+			t.Errorf("Decode() is %+v, want %+v.", d, tc.want)
+		}
+	}
+}
+
+func TestDecodeUint(t *testing.T) {
+	type data struct {
+		Size uint64 `csv:"size"`
+	}
+	testCases := []struct {
+		inp  string
+		want uint64
+		err  error
+	}{
+		{inp: "size\n18446744073709551615\n", want: math.MaxUint64, err: nil},
+		{inp: "size\n+7\n", want: 7, err: nil},
+		{inp: "size\n18446744073709551616\n", err: strconv.ErrRange},
+		{inp: "size\n-1\n", err: strconv.ErrRange},
+		{inp: "size\nxx\n", err: strconv.ErrSyntax},
+	}
+	for _, tc := range testCases {
+		rc := csvdata.NewRows(csvdata.New(strings.NewReader(tc.inp)), true)
+		if err := rc.Next(); err != nil {
+			t.Errorf("Next() is \"%+v\", want nil.", err)
+			continue
+		}
+		var d data
+		err := rc.Decode(&d)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("Decode() is \"%+v\", want \"%+v\".", err, tc.err)
+		}
+		if err == nil && d.Size != tc.want {
+			t.Errorf("Decode() is %+v, want %v.", d, tc.want)
+		}
+	}
+	list := []data{{Size: math.MaxUint64}}
+	buf := &bytes.Buffer{}
+	w := csvdata.NewWriter(buf)
+	if err := csvdata.Encode(w, list); err != nil {
+		t.Fatalf("Encode() is \"%+v\", want nil.", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close() is \"%+v\", want nil.", err)
+	}
+	got, err := csvdata.DecodeAll[data](csvdata.NewRows(csvdata.New(buf), true))
+	if err != nil || len(got) != 1 || got[0] != list[0] {
+		t.Errorf("DecodeAll() is %+v, \"%+v\", want %+v.", got, err, list)
+	}
+}
+
+func TestDecodeError(t *testing.T) {
+	type required struct {
+		Name string `csv:"name,required"`
+	}
+	type badTag struct {
+		Name string `csv:"name,foo"`
+	}
+	type unsupported struct {
+		Name []string `csv:"name"`
+	}
+	testCases := []struct {
+		inp string
+		v   any
+		err error
+	}{
+		{inp: "name,x\n,1\n", v: &required{}, err: csvdata.ErrNullValue},
+		{inp: "foo\nbar\n", v: &required{}, err: csvdata.ErrOutOfIndex},
+		{inp: "name\nfoo\n", v: &badTag{}, err: csvdata.ErrInvalidTag},
+		{inp: "name\nfoo\n", v: &unsupported{}, err: csvdata.ErrUnsupportedType},
+		{inp: "name\nfoo\n", v: required{}, err: csvdata.ErrUnsupportedType},
+	}
+	for _, tc := range testCases {
+		rc := csvdata.NewRows(csvdata.New(strings.NewReader(tc.inp)), true)
+		if err := rc.Next(); err != nil {
+			t.Errorf("Next() is \"%+v\", want nil.", err)
+			continue
+		}
+		if err := rc.Decode(tc.v); !errors.Is(err, tc.err) {
+			t.Errorf("Decode() is \"%+v\", want \"%+v\".", err, tc.err)
+		}
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,189 @@
+package csvdata
+
+import (
+	"database/sql"
+	"encoding"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/goark/errs"
+)
+
+// RowsWriter is interface type for writing rows.
+type RowsWriter interface {
+	Write([]string) error
+	Close() error
+}
+
+var _ RowsWriter = (*Writer)(nil) //Writer is compatible with RowsWriter interface
+
+// Header function returns header strings of struct type T by TagName struct tag.
+func Header[T any]() ([]string, error) {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil, errs.Wrap(ErrUnsupportedType, errs.WithContext("type", t))
+	}
+	si := getStructInfo(t)
+	if si.err != nil {
+		return nil, errs.Wrap(si.err)
+	}
+	header := make([]string, len(si.fields))
+	for i, fi := range si.fields {
+		header[i] = fi.name
+	}
+	return header, nil
+}
+
+// Encode function writes header and all elements in list to RowsWriter.
+// Fields are formatted by the same rules as parsing in Rows, and are associated with columns by TagName struct tag.
+// T must be struct type or pointer to struct type.
+// Encode function does not close RowsWriter.
+func Encode[T any](w RowsWriter, list []T) error {
+	if w == nil {
+		return errs.Wrap(ErrNullPointer)
+	}
+	header, err := Header[T]()
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	if err := w.Write(header); err != nil {
+		return errs.Wrap(err)
+	}
+	for i := range list {
+		rv := reflect.ValueOf(&list[i]).Elem()
+		for rv.Kind() == reflect.Pointer {
+			if rv.IsNil() {
+				return errs.Wrap(ErrNullPointer, errs.WithContext("index", i))
+			}
+			rv = rv.Elem()
+		}
+		record, err := encodeStruct(rv)
+		if err != nil {
+			return errs.Wrap(err, errs.WithContext("index", i))
+		}
+		if err := writeFields(w, record); err != nil {
+			return errs.Wrap(err, errs.WithContext("index", i))
+		}
+	}
+	return nil
+}
+
+func writeFields(w RowsWriter, record []field) error {
+	if cw, ok := w.(*Writer); ok {
+		for _, f := range record {
+			if err := cw.add(f); err != nil {
+				return errs.Wrap(err)
+			}
+		}
+		return cw.EndRecord()
+	}
+	list := make([]string, len(record))
+	for i, f := range record {
+		if !f.null {
+			list[i] = f.value // null field is empty cell
+		}
+	}
+	return w.Write(list)
+}
+
+func encodeStruct(rv reflect.Value) ([]field, error) {
+	si := getStructInfo(rv.Type())
+	if si.err != nil {
+		return nil, errs.Wrap(si.err)
+	}
+	record := make([]field, len(si.fields))
+	for i := range si.fields {
+		fi := &si.fields[i]
+		f, err := encodeField(rv.FieldByIndex(fi.index), fi)
+		if err != nil {
+			return nil, errs.Wrap(err, errs.WithContext("field", fi.field), errs.WithContext("column", fi.name))
+		}
+		record[i] = f
+	}
+	return record, nil
+}
+
+func encodeField(fv reflect.Value, fi *fieldInfo) (field, error) {
+	if fi.omitEmpty && fv.IsZero() {
+		return field{null: true}, nil
+	}
+	switch v := fv.Interface().(type) {
+	case time.Time:
+		return field{value: formatTime(v, fi.layout)}, nil
+	case sql.NullString:
+		return field{value: v.String, null: !v.Valid}, nil
+	case sql.NullBool:
+		return field{value: strconv.FormatBool(v.Bool), null: !v.Valid}, nil
+	case sql.NullFloat64:
+		return field{value: strconv.FormatFloat(v.Float64, 'g', -1, 64), numeric: true, null: !v.Valid}, nil
+	case sql.NullInt64:
+		return field{value: strconv.FormatInt(v.Int64, formatBase(fi.base)), numeric: true, null: !v.Valid}, nil
+	case sql.NullInt32:
+		return field{value: strconv.FormatInt(int64(v.Int32), formatBase(fi.base)), numeric: true, null: !v.Valid}, nil
+	case sql.NullInt16:
+		return field{value: strconv.FormatInt(int64(v.Int16), formatBase(fi.base)), numeric: true, null: !v.Valid}, nil
+	case sql.NullByte:
+		return field{value: strconv.FormatInt(int64(v.Byte), formatBase(fi.base)), numeric: true, null: !v.Valid}, nil
+	case sql.NullTime:
+		if !v.Valid {
+			return field{null: true}, nil
+		}
+		return field{value: formatTime(v.Time, fi.layout)}, nil
+	case encoding.TextMarshaler:
+		if fv.Kind() == reflect.Pointer && fv.IsNil() {
+			return field{null: true}, nil
+		}
+		b, err := v.MarshalText()
+		if err != nil {
+			return field{}, errs.Wrap(err)
+		}
+		return field{value: string(b)}, nil
+	}
+
+	switch fv.Kind() {
+	case reflect.String:
+		return field{value: fv.String()}, nil
+	case reflect.Bool:
+		return field{value: strconv.FormatBool(fv.Bool())}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return field{value: strconv.FormatInt(fv.Int(), formatBase(fi.base)), numeric: true}, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return field{value: strconv.FormatUint(fv.Uint(), formatBase(fi.base)), numeric: true}, nil
+	case reflect.Float32:
+		return field{value: strconv.FormatFloat(fv.Float(), 'g', -1, 32), numeric: true}, nil
+	case reflect.Float64:
+		return field{value: strconv.FormatFloat(fv.Float(), 'g', -1, 64), numeric: true}, nil
+	case reflect.Pointer:
+		if fv.IsNil() {
+			return field{null: true}, nil
+		}
+		return encodeField(fv.Elem(), fi)
+	}
+	return field{}, errs.Wrap(ErrUnsupportedType, errs.WithContext("type", fv.Type()))
+}
+
+func formatTime(t time.Time, layout string) string {
+	if len(layout) == 0 {
+		layout = DefaultTimeLayout
+	}
+	return t.Format(layout)
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,77 @@
+package csvdata_test
+
+import (
+	"bytes"
+	"database/sql"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/goark/csvdata"
+)
+
+type encodeData struct {
+	ID      uint32          `csv:"id,base=16"`
+	Name    string          `csv:"name"`
+	Ratio   float32         `csv:"ratio"`
+	Score   sql.NullFloat64 `csv:"score"`
+	Flag    *bool           `csv:"flag"`
+	Date    time.Time       `csv:"date,layout=2006-01-02"`
+	Updated time.Time       `csv:"updated"`
+	Memo    string          `csv:"memo,omitempty"`
+}
+
+func TestEncodeRoundTrip(t *testing.T) {
+	flag := true
+	list := []encodeData{
+		{ID: 0xbeef, Name: " Mercury, \"the first\"", Ratio: 0.1, Score: sql.NullFloat64{Float64: 1.0 / 3.0, Valid: true}, Flag: &flag, Date: time.Date(2023, time.March, 14, 0, 0, 0, 0, time.UTC), Updated: time.Date(2023, time.March, 14, 15, 9, 26, 535897932, time.UTC), Memo: "multi\nline"},
+		{ID: 2, Name: "Venus"},
+	}
+	buf := &bytes.Buffer{}
+	w := csvdata.NewWriter(buf).WithNullToken("")
+	if err := csvdata.Encode(w, list); err != nil {
+		t.Fatalf("Encode() is \"%+v\", want nil.", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close() is \"%+v\", want nil.", err)
+	}
+	if header, _, _ := strings.Cut(buf.String(), "\n"); header != "id,name,ratio,score,flag,date,updated,memo" {
+		t.Errorf("header is %q, want %q.", header, "id,name,ratio,score,flag,date,updated,memo")
+	}
+	got, err := csvdata.DecodeAll[encodeData](csvdata.NewRows(csvdata.New(buf), true))
+	if err != nil {
+		t.Fatalf("DecodeAll() is \"%+v\", want nil.", err)
+	}
+	if !reflect.DeepEqual(got, list) {
+		t.Errorf("DecodeAll() is %+v, want %+v.", got, list)
+	}
+}
+
+func TestEncodeError(t *testing.T) {
+	if err := csvdata.Encode(csvdata.NewWriter(&bytes.Buffer{}), []int{1}); !errors.Is(err, csvdata.ErrUnsupportedType) {
+		t.Errorf("Encode() is \"%+v\", want \"%+v\".", err, csvdata.ErrUnsupportedType)
+	}
+	if err := csvdata.Encode(csvdata.NewWriter(&bytes.Buffer{}), []*encodeData{nil}); !errors.Is(err, csvdata.ErrNullPointer) {
+		t.Errorf("Encode() is \"%+v\", want \"%+v\".", err, csvdata.ErrNullPointer)
+	}
+	if err := csvdata.Encode[encodeData](nil, nil); !errors.Is(err, csvdata.ErrNullPointer) {
+		t.Errorf("Encode() is \"%+v\", want \"%+v\".", err, csvdata.ErrNullPointer)
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,70 @@
+package csvdata_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/goark/csvdata"
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/encoding/unicode"
+)
+
+func TestEncoding(t *testing.T) {
+	const src = "order,name\n1,水星\n"
+	testCases := []struct {
+		name string
+		enc  encoding.Encoding // encoding for input data
+		opt  encoding.Encoding // WithEncoding option
+	}{
+		{name: "UTF-8", enc: unicode.UTF8, opt: nil},
+		{name: "UTF-8 with BOM", enc: unicode.UTF8BOM, opt: nil},
+		{name: "UTF-16LE with BOM", enc: unicode.UTF16(unicode.LittleEndian, unicode.UseBOM), opt: nil},
+		{name: "UTF-16BE with BOM", enc: unicode.UTF16(unicode.BigEndian, unicode.UseBOM), opt: japanese.ShiftJIS},
+		{name: "UTF-16LE without BOM", enc: unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM), opt: unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM)},
+		{name: "Shift_JIS", enc: japanese.ShiftJIS, opt: japanese.ShiftJIS},
+		{name: "EUC-JP", enc: japanese.EUCJP, opt: japanese.EUCJP},
+	}
+	for _, tc := range testCases {
+		b, err := tc.enc.NewEncoder().Bytes([]byte(src))
+		if err != nil {
+			t.Fatalf("%s: Encoder.Bytes() is \"%+v\", want nil.", tc.name, err)
+		}
+		rc := csvdata.NewRows(csvdata.New(io.NopCloser(bytes.NewReader(b))).WithEncoding(tc.opt), true)
+		if err := rc.Next(); err != nil {
+			t.Errorf("%s: Next() is \"%+v\", want nil.", tc.name, err)
+			continue
+		}
+		if n, err := rc.ColumnInt64("order", 10); err != nil || n != 1 {
+			t.Errorf("%s: ColumnInt64() is %v, \"%+v\", want %v.", tc.name, n, err, 1)
+		}
+		if s := rc.Column("name"); s != "水星" {
+			t.Errorf("%s: Column() is %q, want %q.", tc.name, s, "水星")
+		}
+	}
+}
+
+func TestBOMOnly(t *testing.T) {
+	rc := csvdata.NewRows(csvdata.New(strings.NewReader("\ufeff")), true)
+	if err := rc.Next(); !errors.Is(err, io.EOF) {
+		t.Errorf("Next() is \"%+v\", want \"%+v\".", err, io.EOF)
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -3,12 +3,20 @@ package csvdata
 import "errors"
 
 var (
-	ErrNullPointer      = errors.New("null reference instance")
-	ErrNullValue        = errors.New("null value")
-	ErrInvalidRecord    = errors.New("invalid record")
-	ErrOutOfIndex       = errors.New("out of index")
-	ErrInvalidSheetName = errors.New("invalid sheet name in Excel data")
-	ErrInvalidExcelData = errors.New("invalid Excel data")
+	ErrNullPointer       = errors.New("null reference instance")
+	ErrNullValue         = errors.New("null value")
+	ErrInvalidRecord     = errors.New("invalid record")
+	ErrOutOfIndex        = errors.New("out of index")
+	ErrInvalidSheetName  = errors.New("invalid sheet name in Excel data")
+	ErrInvalidExcelData  = errors.New("invalid Excel data")
+	ErrUnsupportedType   = errors.New("unsupported type")
+	ErrInvalidTag        = errors.New("invalid struct tag")
+	ErrInvalidDelimiter  = errors.New("invalid field delimiter")
+	ErrTooManyErrors     = errors.New("too many invalid records")
+	ErrSchemaViolation   = errors.New("schema violation")
+	ErrInvalidSerialDate = errors.New("invalid serial number of date")
+	ErrDuplicateHeader   = errors.New("duplicate header name")
+	ErrEmptyHeader       = errors.New("empty header name")
 )
 
 /* Copyright 2021 Spiegel
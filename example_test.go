@@ -1,7 +1,9 @@
 package csvdata_test
 
 import (
+	"database/sql"
 	"fmt"
+	"os"
 
 	"github.com/goark/csvdata"
 )
@@ -24,6 +26,79 @@ func ExampleNew() {
 	// Mercury
 }
 
+func ExampleRows_All() {
+	file, err := csvdata.OpenFile("testdata/sample.csv")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	rc := csvdata.NewRows(csvdata.New(file), true)
+	defer rc.Close()
+
+	for i, row := range rc.All() {
+		fmt.Println(i, row.Column("name"))
+	}
+	if err := rc.Err(); err != nil {
+		fmt.Println(err)
+	}
+	// Output:
+	// 0 Mercury
+	// 1 Venus
+	// 2 Earth
+	// 3 Mars
+}
+
+func ExampleDecodeAll() {
+	type planet struct {
+		Order     int     `csv:"order,required"`
+		Name      string  `csv:"name"`
+		Mass      float64 `csv:"mass"`
+		Habitable bool    `csv:"habitable"`
+	}
+	file, err := csvdata.OpenFile("testdata/sample.csv")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	rc := csvdata.NewRows(csvdata.New(file).WithTrimSpace(true), true)
+	defer rc.Close()
+
+	planets, err := csvdata.DecodeAll[planet](rc)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	for _, p := range planets {
+		fmt.Printf("%+v\n", p)
+	}
+	// Output:
+	// {Order:1 Name:Mercury Mass:0.055 Habitable:false}
+	// {Order:2 Name:Venus Mass:0.815 Habitable:false}
+	// {Order:3 Name:Earth Mass:1 Habitable:true}
+	// {Order:4 Name:Mars Mass:0.107 Habitable:false}
+}
+
+func ExampleNewWriter() {
+	w := csvdata.NewWriter(os.Stdout).WithNullToken("NULL")
+	defer w.Flush()
+
+	if err := w.WriteHeader([]string{"order", "name", "mass", "habitable"}); err != nil {
+		fmt.Println(err)
+		return
+	}
+	_ = w.WriteInt64(1, 10)
+	_ = w.WriteString("Mercury")
+	_ = w.WriteNullFloat64(sql.NullFloat64{})
+	_ = w.WriteBool(false)
+	if err := w.EndRecord(); err != nil {
+		fmt.Println(err)
+		return
+	}
+	// Output:
+	// order,name,mass,habitable
+	// 1,Mercury,NULL,false
+}
+
 /* Copyright 2021 Spiegel
  *
  * Licensed under the Apache License, Version 2.0 (the "License");
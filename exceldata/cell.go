@@ -0,0 +1,167 @@
+package exceldata
+
+import (
+	"encoding/xml"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/goark/csvdata"
+	"github.com/xuri/excelize/v2"
+)
+
+// builtInDateFormats is set of built-in number format IDs for date/time.
+var builtInDateFormats = map[int]bool{
+	14: true, 15: true, 16: true, 17: true, 18: true, 19: true, 20: true, 21: true, 22: true,
+	27: true, 28: true, 29: true, 30: true, 31: true, 32: true, 33: true, 34: true, 35: true, 36: true,
+	45: true, 46: true, 47: true,
+	50: true, 51: true, 52: true, 53: true, 54: true, 55: true, 56: true, 57: true, 58: true,
+}
+
+// styleSheet is subset of styles.xml for detecting date format.
+type styleSheet struct {
+	NumFmts []struct {
+		ID   int    `xml:"numFmtId,attr"`
+		Code string `xml:"formatCode,attr"`
+	} `xml:"numFmts>numFmt"`
+	CellXfs []struct {
+		NumFmtID int `xml:"numFmtId,attr"`
+	} `xml:"cellXfs>xf"`
+}
+
+// dateFormats returns set of style IDs formatted as date/time.
+func dateFormats(xlsx *excelize.File) map[int]bool {
+	var data []byte
+	if xlsx.Styles != nil {
+		data, _ = xml.Marshal(xlsx.Styles)
+	} else if v, ok := xlsx.Pkg.Load("xl/styles.xml"); ok {
+		data, _ = v.([]byte)
+	}
+	ss := &styleSheet{}
+	if err := xml.Unmarshal(data, ss); err != nil {
+		return map[int]bool{}
+	}
+	custom := map[int]bool{}
+	for _, nf := range ss.NumFmts {
+		custom[nf.ID] = isDateFormatCode(nf.Code)
+	}
+	styles := map[int]bool{}
+	for i, xf := range ss.CellXfs {
+		if date, ok := custom[xf.NumFmtID]; ok {
+			styles[i] = date
+		} else {
+			styles[i] = builtInDateFormats[xf.NumFmtID]
+		}
+	}
+	return styles
+}
+
+// isDateFormatCode reports whether number format code includes date/time tokens.
+func isDateFormatCode(code string) bool {
+	section, _, _ := strings.Cut(code, ";")
+	inQuote, inBracket := false, false
+	for i := 0; i < len(section); i++ {
+		c := section[i]
+		switch {
+		case inQuote:
+			inQuote = c != '"'
+		case inBracket:
+			if c == ']' {
+				inBracket = false
+			} else if c == 'h' || c == 'H' || c == 'm' || c == 'M' || c == 's' || c == 'S' {
+				return true // elapsed time (e.g. [h]:mm)
+			}
+		case c == '"':
+			inQuote = true
+		case c == '[':
+			inBracket = true
+		case c == '\\' || c == '_' || c == '*':
+			i++ // skip next character
+		default:
+			switch c {
+			case 'y', 'Y', 'm', 'M', 'd', 'D', 'h', 'H', 's', 'S':
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// WithRawValue method sets mode for reading raw cell values (e.g. serial number of date) instead of formatted strings.
+// In this mode, Reader provides typed values of cells by Cell method.
+func (r *Reader) WithRawValue(mode bool) *Reader {
+	if r == nil {
+		return nil
+	}
+	r.raw = mode
+	return r
+}
+
+// Cell method returns typed value of field in the last read row. It is available in raw value mode (WithRawValue method).
+// This method is a implementation of csvdata.TypedReader interface.
+func (r *Reader) Cell(field int) (csvdata.Cell, bool) {
+	if r == nil || !r.raw || r.rowNum == 0 || field < 0 || field >= len(r.record) {
+		return csvdata.Cell{}, false
+	}
+	name := r.anchor(r.column(field))
+	info, err := r.cellInfo(name)
+	if err != nil {
+		return csvdata.Cell{}, false
+	}
+	c := csvdata.Cell{Value: r.record[field], Formula: info.formula}
+	if len(c.Value) == 0 {
+		return c, true
+	}
+	switch info.typ {
+	case excelize.CellTypeBool:
+		c.Type, c.Bool = csvdata.CellBool, c.Value == "1" || strings.EqualFold(c.Value, "true")
+	case excelize.CellTypeError:
+		c.Type = csvdata.CellError
+	case excelize.CellTypeDate:
+		tm, err := time.Parse("2006-01-02T15:04:05.999999999", strings.TrimSuffix(c.Value, "Z"))
+		if err != nil {
+			c.Type = csvdata.CellString
+			break
+		}
+		c.Type, c.Time = csvdata.CellDate, tm
+		c.Number, _ = csvdata.TimeToSerial(tm, r.DateSystem())
+	case excelize.CellTypeUnset, excelize.CellTypeNumber:
+		f, err := strconv.ParseFloat(c.Value, 64)
+		if err != nil {
+			c.Type = csvdata.CellString
+			break
+		}
+		c.Type, c.Number = csvdata.CellNumber, f
+		if r.isDate(info.style) {
+			if tm, err := csvdata.SerialToTime(f, r.DateSystem()); err == nil {
+				c.Type, c.Time = csvdata.CellDate, tm
+			}
+		}
+	default:
+		c.Type = csvdata.CellString
+	}
+	return c, true
+}
+
+// isDate method reports whether style of cell is formatted as date/time.
+func (r *Reader) isDate(style int) bool {
+	if r.dateStyles == nil {
+		r.dateStyles = dateFormats(r.xlsx)
+	}
+	return r.dateStyles[style]
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,268 @@
+package exceldata_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/goark/csvdata"
+	"github.com/goark/csvdata/exceldata"
+	"github.com/xuri/excelize/v2"
+)
+
+func typedFile(t *testing.T) *excelize.File {
+	t.Helper()
+	f := excelize.NewFile()
+	dateStyle, err := f.NewStyle(&excelize.Style{NumFmt: 14})
+	if err != nil {
+		t.Fatal(err)
+	}
+	customStyle, err := f.NewStyle(&excelize.Style{CustomNumFmt: func(s string) *string { return &s }(`yyyy"年"m"月"d"日"`)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	numStyle, err := f.NewStyle(&excelize.Style{NumFmt: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows := [][]any{
+		{"name", "date", "custom", "mass", "habitable", "formula"},
+		{"Earth", 45000.5, 45000, 0.123456789, true, nil},
+	}
+	for i, row := range rows {
+		cell, _ := excelize.CoordinatesToCellName(1, i+1)
+		if err := f.SetSheetRow("Sheet1", cell, &row); err != nil {
+			t.Fatal(err)
+		}
+	}
+	_ = f.SetCellStyle("Sheet1", "B2", "B2", dateStyle)
+	_ = f.SetCellStyle("Sheet1", "C2", "C2", customStyle)
+	_ = f.SetCellStyle("Sheet1", "D2", "D2", numStyle)
+	_ = f.SetCellValue("Sheet1", "F2", 0.246913578)
+	_ = f.SetCellFormula("Sheet1", "F2", "D2*2") // result is string type in excelize
+	buf := &bytes.Buffer{}
+	if err := f.Write(buf); err != nil {
+		t.Fatal(err)
+	}
+	xlsx, err := excelize.OpenReader(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return xlsx
+}
+
+func TestRawValue(t *testing.T) {
+	r, err := exceldata.New(typedFile(t), "")
+	if err != nil {
+		t.Fatalf("New() is \"%+v\", want nil.", err)
+	}
+	rc := csvdata.NewRows(r.WithRawValue(true), true)
+	defer rc.Close() //dummy
+	if err := rc.Next(); err != nil {
+		t.Fatalf("Next() is \"%+v\", want nil.", err)
+	}
+	want := time.Date(2023, time.March, 15, 12, 0, 0, 0, time.UTC)
+	if tm, err := rc.ColumnTime("date", ""); err != nil || !tm.Equal(want) {
+		t.Errorf("ColumnTime() is %v, \"%+v\", want %v.", tm, err, want)
+	}
+	if tm, err := rc.ColumnTime("custom", ""); err != nil || !tm.Equal(want.Add(-12*time.Hour)) {
+		t.Errorf("ColumnTime() is %v, \"%+v\", want %v.", tm, err, want.Add(-12*time.Hour))
+	}
+	if f, err := rc.ColumnFloat64("date"); err != nil || f != 45000.5 {
+		t.Errorf("ColumnFloat64() is %v, \"%+v\", want %v.", f, err, 45000.5)
+	}
+	if f, err := rc.ColumnFloat64("mass"); err != nil || f != 0.123456789 {
+		t.Errorf("ColumnFloat64() is %v, \"%+v\", want %v.", f, err, 0.123456789)
+	}
+	if b, err := rc.ColumnBool("habitable"); err != nil || !b {
+		t.Errorf("ColumnBool() is %v, \"%+v\", want true.", b, err)
+	}
+	testCases := []struct {
+		field   int
+		typ     csvdata.CellType
+		formula string
+	}{
+		{field: 0, typ: csvdata.CellString},
+		{field: 1, typ: csvdata.CellDate},
+		{field: 2, typ: csvdata.CellDate},
+		{field: 3, typ: csvdata.CellNumber},
+		{field: 4, typ: csvdata.CellBool},
+		{field: 5, typ: csvdata.CellString, formula: "D2*2"},
+	}
+	for _, tc := range testCases {
+		c, ok := r.Cell(tc.field)
+		if !ok || c.Type != tc.typ || c.Formula != tc.formula {
+			t.Errorf("Cell(%v) is %+v, %v, want {Type:%v Formula:%v}.", tc.field, c, ok, tc.typ, tc.formula)
+		}
+	}
+}
+
+func TestInferDateCell(t *testing.T) {
+	r, err := exceldata.New(typedFile(t), "")
+	if err != nil {
+		t.Fatalf("New() is \"%+v\", want nil.", err)
+	}
+	p, err := csvdata.Infer(r.WithRawValue(true), 0)
+	if err != nil {
+		t.Fatalf("Infer() is \"%+v\", want nil.", err)
+	}
+	testCases := []struct {
+		name string
+		typ  csvdata.ColumnType
+	}{
+		{name: "date", typ: csvdata.TypeTime},
+		{name: "custom", typ: csvdata.TypeTime},
+		{name: "mass", typ: csvdata.TypeFloat},
+	}
+	for _, tc := range testCases {
+		for _, c := range p.Columns {
+			if c.Name == tc.name && c.Type != tc.typ {
+				t.Errorf("Infer().Columns[%q] is %+v, want {Type:%v}.", tc.name, c, tc.typ)
+			}
+		}
+	}
+}
+
+func TestFormattedValue(t *testing.T) {
+	r, err := exceldata.New(typedFile(t), "")
+	if err != nil {
+		t.Fatalf("New() is \"%+v\", want nil.", err)
+	}
+	rc := csvdata.NewRows(r, true)
+	defer rc.Close() //dummy
+	if err := rc.Next(); err != nil {
+		t.Fatalf("Next() is \"%+v\", want nil.", err)
+	}
+	if s := rc.Column("mass"); s != "0.12" {
+		t.Errorf("Column() is %q, want %q.", s, "0.12")
+	}
+	if _, ok := r.Cell(3); ok {
+		t.Error("Cell() is available, want not.")
+	}
+}
+
+func TestDate1904(t *testing.T) {
+	f := excelize.NewFile()
+	date1904 := true
+	if err := f.SetWorkbookProps(&excelize.WorkbookPropsOptions{Date1904: &date1904}); err != nil {
+		t.Fatal(err)
+	}
+	row := []any{"date", 43538.5}
+	if err := f.SetSheetCol("Sheet1", "A1", &row); err != nil {
+		t.Fatal(err)
+	}
+	for _, raw := range []bool{false, true} {
+		r, err := exceldata.New(f, "")
+		if err != nil {
+			t.Fatalf("New() is \"%+v\", want nil.", err)
+		}
+		if ds := r.DateSystem(); ds != csvdata.DateSystem1904 {
+			t.Errorf("DateSystem() is %v, want %v.", ds, csvdata.DateSystem1904)
+		}
+		rc := csvdata.NewRows(r.WithRawValue(raw), true)
+		if err := rc.Next(); err != nil {
+			t.Fatalf("Next() is \"%+v\", want nil.", err)
+		}
+		want := time.Date(2023, time.March, 15, 12, 0, 0, 0, time.UTC)
+		if tm, err := rc.ColumnTime("date", csvdata.LayoutSerial); err != nil || !tm.Equal(want) {
+			t.Errorf("ColumnTime() is %v, \"%+v\", want %v.", tm, err, want)
+		}
+	}
+}
+
+func TestISODate(t *testing.T) {
+	sheet := `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>
+<row r="1"><c r="A1" t="inlineStr"><is><t>date</t></is></c><c r="B1" t="inlineStr"><is><t>name</t></is></c></row>
+<row r="2"><c r="A2" t="d"><v>2023-03-15T12:00:00Z</v></c><c r="B2" t="inlineStr"><is><t>Earth</t></is></c></row>
+<row r="4"><c r="A4" t="d"><v>1900-01-01T00:00:00Z</v></c><c r="B4" t="str"><f>UPPER(B2)</f><v>EARTH</v></c></row>
+</sheetData></worksheet>`
+	f := excelize.NewFile()
+	buf := &bytes.Buffer{}
+	if err := f.Write(buf); err != nil {
+		t.Fatal(err)
+	}
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := &bytes.Buffer{}
+	zw := zip.NewWriter(out)
+	for _, zf := range zr.File {
+		w, err := zw.Create(zf.Name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if zf.Name == "xl/worksheets/sheet1.xml" {
+			_, _ = w.Write([]byte(sheet))
+			continue
+		}
+		rc, err := zf.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, _ = io.Copy(w, rc)
+		rc.Close()
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	xlsx, err := excelize.OpenReader(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := exceldata.New(xlsx, "")
+	if err != nil {
+		t.Fatalf("New() is \"%+v\", want nil.", err)
+	}
+	r = r.WithRawValue(true)
+	testCases := []struct {
+		date    time.Time
+		serial  float64
+		name    string
+		typ     csvdata.CellType
+		formula string
+	}{
+		{date: time.Date(2023, time.March, 15, 12, 0, 0, 0, time.UTC), serial: 45000.5, name: "Earth", typ: csvdata.CellString},
+		{date: time.Date(1900, time.January, 1, 0, 0, 0, 0, time.UTC), serial: 1, name: "EARTH", typ: csvdata.CellString, formula: "UPPER(B2)"},
+	}
+	if _, err := r.Read(); err != nil {
+		t.Fatalf("Read() is \"%+v\", want nil.", err)
+	}
+	for _, tc := range testCases {
+		for {
+			rec, err := r.Read()
+			if err != nil {
+				t.Fatalf("Read() is \"%+v\", want nil.", err)
+			}
+			if len(rec) > 0 && len(rec[0]) > 0 {
+				break
+			}
+		}
+		c, ok := r.Cell(0)
+		if !ok || c.Type != csvdata.CellDate || !c.Time.Equal(tc.date) || c.Number != tc.serial {
+			t.Errorf("Cell(0) is %+v, %v, want {Type:%v Time:%v Number:%v}.", c, ok, csvdata.CellDate, tc.date, tc.serial)
+		}
+		c, ok = r.Cell(1)
+		if !ok || c.Type != tc.typ || c.Value != tc.name || c.Formula != tc.formula {
+			t.Errorf("Cell(1) is %+v, %v, want {Value:%v Type:%v Formula:%v}.", c, ok, tc.name, tc.typ, tc.formula)
+		}
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
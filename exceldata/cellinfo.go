@@ -0,0 +1,171 @@
+package exceldata
+
+import (
+	"bytes"
+	"encoding/xml"
+
+	"github.com/goark/errs"
+	"github.com/xuri/excelize/v2"
+)
+
+// cellTypes is map of t attribute of c element to cell type (the same as excelize.File.GetCellType method).
+var cellTypes = map[string]excelize.CellType{
+	"b":         excelize.CellTypeBool,
+	"d":         excelize.CellTypeDate,
+	"n":         excelize.CellTypeNumber,
+	"e":         excelize.CellTypeError,
+	"s":         excelize.CellTypeSharedString,
+	"str":       excelize.CellTypeFormula,
+	"inlineStr": excelize.CellTypeInlineString,
+}
+
+// cellInfo is type, style and formula of a cell.
+type cellInfo struct {
+	typ     excelize.CellType
+	style   int
+	formula string
+	shared  bool // formula is shared from other cell (text of formula is not available)
+}
+
+// xlsxRowInfo is row element in worksheet with attributes of cells.
+type xlsxRowInfo struct {
+	R int `xml:"r,attr"`
+	S int `xml:"s,attr"`
+	C []struct {
+		R string `xml:"r,attr"`
+		S int    `xml:"s,attr"`
+		T string `xml:"t,attr"`
+		F *struct {
+			Content string `xml:",chardata"`
+			T       string `xml:"t,attr"`
+		} `xml:"f"`
+	} `xml:"c"`
+}
+
+// cellInfoStream decodes worksheet row by row in step with Reader.Read method, instead of random access to cells by excelize.File.
+type cellInfoStream struct {
+	decoder *xml.Decoder
+	row     int // row number of cells
+	last    int // the last requested row number
+	cells   map[int]cellInfo
+	done    bool
+}
+
+// newCellInfoStream function returns cellInfoStream instance for the sheet, or nil if worksheet is not available in memory
+// (e.g. large worksheet extracted into temporary file by excelize).
+func newCellInfoStream(xlsx *excelize.File, sheet string) *cellInfoStream {
+	part, ok := sheetParts(xlsx)[sheet]
+	if !ok {
+		return nil
+	}
+	data := readPart(xlsx, part)
+	if data == nil {
+		return nil
+	}
+	return &cellInfoStream{decoder: xml.NewDecoder(bytes.NewReader(data)), cells: map[int]cellInfo{}}
+}
+
+// at method returns cells in the row by column number. Rows must be requested in ascending order;
+// it returns false if the row has been passed already.
+func (s *cellInfoStream) at(row int) (map[int]cellInfo, bool) {
+	if row < s.last {
+		return nil, false
+	}
+	s.last = row
+	for !s.done && s.row < row {
+		if err := s.next(); err != nil {
+			s.done = true
+		}
+	}
+	if s.row != row {
+		return nil, true // empty row
+	}
+	return s.cells, true
+}
+
+// next method decodes next row element.
+func (s *cellInfoStream) next() error {
+	for {
+		tok, err := s.decoder.Token()
+		if err != nil {
+			return errs.Wrap(err)
+		}
+		se, ok := tok.(xml.StartElement)
+		if !ok || se.Name.Local != "row" {
+			continue
+		}
+		var row xlsxRowInfo
+		if err := s.decoder.DecodeElement(&row, &se); err != nil {
+			return errs.Wrap(err)
+		}
+		if row.R > 0 {
+			s.row = row.R
+		} else {
+			s.row++
+		}
+		clear(s.cells)
+		col := 0
+		for _, c := range row.C {
+			if n, _, err := excelize.CellNameToCoordinates(c.R); err == nil {
+				col = n
+			} else {
+				col++
+			}
+			info := cellInfo{typ: cellTypes[c.T], style: c.S}
+			if info.style == 0 {
+				info.style = row.S
+			}
+			if c.F != nil {
+				info.formula = c.F.Content
+				info.shared = c.F.T == "shared" && len(c.F.Content) == 0
+			}
+			s.cells[col] = info
+		}
+		return nil
+	}
+}
+
+// cellInfo method returns type, style and formula of the cell.
+// It uses worksheet decoded in step with Read method, or lookups by excelize.File if it is not available.
+func (r *Reader) cellInfo(name string) (cellInfo, error) {
+	col, row, err := excelize.CellNameToCoordinates(name)
+	if err != nil {
+		return cellInfo{}, errs.Wrap(err)
+	}
+	if !r.infoLoaded {
+		r.infoLoaded, r.infos = true, newCellInfoStream(r.xlsx, r.sheet)
+	}
+	if r.infos != nil {
+		if cells, ok := r.infos.at(row); ok {
+			info := cells[col]
+			if info.shared {
+				info.formula, _ = r.xlsx.GetCellFormula(r.sheet, name)
+			}
+			return info, nil
+		}
+	}
+	// random access (e.g. anchor cell of merged region in previous row)
+	typ, err := r.xlsx.GetCellType(r.sheet, name)
+	if err != nil {
+		return cellInfo{}, errs.Wrap(err)
+	}
+	info := cellInfo{typ: typ}
+	info.formula, _ = r.xlsx.GetCellFormula(r.sheet, name)
+	info.style, _ = r.xlsx.GetCellStyle(r.sheet, name)
+	return info, nil
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -30,6 +30,33 @@ func ExampleNew() {
 	// Mercury
 }
 
+func Example_all() {
+	xlsx, err := exceldata.OpenFile("testdata/sample.xlsx", "")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	r, err := exceldata.New(xlsx, "")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	rc := csvdata.NewRows(r, true)
+	defer rc.Close() //dummy
+
+	for _, row := range rc.All() {
+		fmt.Println(row.Column("name"))
+	}
+	if err := rc.Err(); err != nil {
+		fmt.Println(err)
+	}
+	// Output:
+	// Mercury
+	//  Venus
+	//  Earth
+	//  Mars
+}
+
 /* Copyright 2021 Spiegel
  *
  * Licensed under the Apache License, Version 2.0 (the "License");
@@ -10,10 +10,29 @@ import (
 
 // Reader is class of Excel data
 type Reader struct {
-	rows *excelize.Rows
+	xlsx       *excelize.File
+	sheet      string
+	rows       *excelize.Rows
+	rng        *cellRange // range of cells (nil if whole sheet)
+	rowNum     int        // row number of the last returned record
+	cursor     int        // row number of the last scanned row
+	trimmer    csvdata.Trimmer
+	raw        bool
+	fillMerged bool
+	merged     []mergedCell // merged regions (loaded at the first Read method call in fillMerged mode)
+	record     []string
+	dateStyles map[int]bool    // style IDs formatted as date/time
+	infos      *cellInfoStream // cell information decoded in step with Read method
+	infoLoaded bool            // infos is initialized
+	date1904   bool
 }
 
-var _ csvdata.RowsReader = (*Reader)(nil) //Reader is compatible with csvdata.RowsReader interface
+var (
+	_ csvdata.RowsReader       = (*Reader)(nil) //Reader is compatible with csvdata.RowsReader interface
+	_ csvdata.PositionReader   = (*Reader)(nil) //Reader is compatible with csvdata.PositionReader interface
+	_ csvdata.TypedReader      = (*Reader)(nil) //Reader is compatible with csvdata.TypedReader interface
+	_ csvdata.DateSystemReader = (*Reader)(nil) //Reader is compatible with csvdata.DateSystemReader interface
+)
 
 // OpenFile returns Excel file instance.
 func OpenFile(path, password string) (*excelize.File, error) {
@@ -25,9 +44,21 @@ func OpenFile(path, password string) (*excelize.File, error) {
 }
 
 // New function creates a new Reader instance.
+// sheetName is one of the following (empty string means the first sheet):
+//
+//   - sheet name (e.g. "Sheet1")
+//   - range of cells in A1 style with sheet name (e.g. "Sheet1!B4:H200"), or without sheet name for the first sheet (e.g. "B4:H200")
+//   - defined name (named range)
+//   - name of Excel table (ListObject)
+//
+// If range is specified, Reader returns only rows and columns inside the range.
 func New(xlsx *excelize.File, sheetName string) (*Reader, error) {
-	if len(sheetName) == 0 {
-		sheetName = xlsx.GetSheetName(0)
+	if xlsx == nil {
+		return nil, errs.Wrap(csvdata.ErrNullPointer)
+	}
+	sheetName, rng, err := resolveRange(xlsx, sheetName)
+	if err != nil {
+		return nil, errs.Wrap(err)
 	}
 	rows, err := xlsx.Rows(sheetName)
 	if err != nil {
@@ -37,7 +68,11 @@ func New(xlsx *excelize.File, sheetName string) (*Reader, error) {
 		}
 		return nil, errs.Wrap(err)
 	}
-	return &Reader{rows}, nil
+	r := &Reader{xlsx: xlsx, sheet: sheetName, rows: rows, rng: rng}
+	if props, err := xlsx.GetWorkbookProps(); err == nil && props.Date1904 != nil {
+		r.date1904 = *props.Date1904
+	}
+	return r, nil
 }
 
 // TrimSpace returns false.
@@ -50,22 +85,103 @@ func (r *Reader) LazyQuotes() bool {
 	return true
 }
 
+// DateSystem method returns date system of the workbook (DateSystem1900 or DateSystem1904).
+// This method is a implementation of csvdata.DateSystemReader interface.
+func (r *Reader) DateSystem() csvdata.DateSystem {
+	if r != nil && r.date1904 {
+		return csvdata.DateSystem1904
+	}
+	return csvdata.DateSystem1900
+}
+
+// WithTrim method sets mode for trimming empty rows and columns.
+func (r *Reader) WithTrim(mode csvdata.TrimMode) *Reader {
+	if r == nil {
+		return nil
+	}
+	r.trimmer.Mode = mode
+	return r
+}
+
 // Read method returns next row data.
 func (r *Reader) Read() ([]string, error) {
 	if r == nil {
 		return nil, errs.Wrap(csvdata.ErrNullPointer)
 	}
-	if r.rows.Next() {
-		cols, err := r.rows.Columns()
-		return cols, errs.Wrap(err)
+	if r.fillMerged && r.merged == nil {
+		if err := r.loadMerged(); err != nil {
+			return nil, errs.Wrap(err)
+		}
+	}
+	cols, row, err := r.trimmer.Read(r.readRow)
+	if row > 0 {
+		r.rowNum, r.record = row, cols
+	}
+	return cols, err
+}
+
+// readRow method scans next row in the sheet (or range), and returns the row data with row number.
+func (r *Reader) readRow() ([]string, int, error) {
+	for r.rng == nil || r.cursor < r.rng.bottom {
+		if !r.rows.Next() {
+			break
+		}
+		r.cursor++
+		if r.rng != nil && r.cursor < r.rng.top {
+			continue
+		}
+		cols, err := r.rows.Columns(excelize.Options{RawCellValue: r.raw})
+		if r.fillMerged {
+			cols = r.fill(cols)
+		}
+		cols = r.clip(cols)
+		if err != nil {
+			return cols, r.cursor, r.recordError(err)
+		}
+		return cols, r.cursor, nil
 	}
 	if err := r.rows.Error(); err != nil {
 		if errs.Is(err, io.EOF) {
-			return nil, errs.Wrap(err)
+			return nil, 0, errs.Wrap(err)
 		}
-		return nil, errs.Wrap(csvdata.ErrInvalidRecord, errs.WithCause(err))
+		return nil, 0, r.recordError(err)
+	}
+	return nil, 0, errs.Wrap(io.EOF)
+}
+
+// clip method returns columns inside range.
+func (r *Reader) clip(cols []string) []string {
+	if r.rng == nil {
+		return cols
 	}
-	return nil, errs.Wrap(io.EOF)
+	width := r.rng.right - r.rng.left + 1
+	clipped := make([]string, width)
+	if r.rng.left <= len(cols) {
+		copy(clipped, cols[r.rng.left-1:])
+	}
+	return clipped
+}
+
+// column method returns column number in sheet (1-based) of field.
+func (r *Reader) column(field int) int {
+	if r.rng == nil {
+		return field + 1
+	}
+	return field + r.rng.left
+}
+
+// Position method returns position of field in the last read row.
+func (r *Reader) Position(field int) csvdata.Position {
+	if r == nil || r.rowNum == 0 || field < 0 {
+		return csvdata.Position{}
+	}
+	col := r.column(field)
+	return csvdata.Position{Line: r.rowNum, Column: col, Cell: csvdata.CellName(col, r.rowNum)}
+}
+
+func (r *Reader) recordError(err error) error {
+	fe := &csvdata.FieldError{Position: csvdata.Position{Line: r.cursor}, Index: -1, Err: errs.Wrap(csvdata.ErrInvalidRecord, errs.WithCause(err))}
+	return errs.Wrap(fe, errs.WithContext("row", r.cursor))
 }
 
 // Close method is dummy.
@@ -0,0 +1,88 @@
+package exceldata
+
+import (
+	"github.com/goark/csvdata"
+	"github.com/goark/errs"
+	"github.com/xuri/excelize/v2"
+)
+
+// mergedCell is merged region of cells with value of the top-left (anchor) cell.
+type mergedCell struct {
+	cellRange
+	anchor string
+	value  string
+}
+
+// WithFillMerged method sets mode for filling all cells in merged region with value of the top-left (anchor) cell.
+func (r *Reader) WithFillMerged(mode bool) *Reader {
+	if r == nil {
+		return nil
+	}
+	r.fillMerged = mode
+	return r
+}
+
+// loadMerged method gets merged regions in the sheet.
+func (r *Reader) loadMerged() error {
+	mcs, err := r.xlsx.GetMergeCells(r.sheet)
+	if err != nil {
+		return errs.Wrap(err, errs.WithContext("SheetName", r.sheet))
+	}
+	r.merged = make([]mergedCell, 0, len(mcs))
+	for _, mc := range mcs {
+		rng, err := parseRange(mc.GetStartAxis() + ":" + mc.GetEndAxis())
+		if err != nil {
+			return errs.Wrap(err)
+		}
+		anchor := csvdata.CellName(rng.left, rng.top)
+		value, err := r.xlsx.GetCellValue(r.sheet, anchor, excelize.Options{RawCellValue: r.raw})
+		if err != nil {
+			return errs.Wrap(err, errs.WithContext("cell", anchor))
+		}
+		r.merged = append(r.merged, mergedCell{cellRange: *rng, anchor: anchor, value: value})
+	}
+	return nil
+}
+
+// fill method fills cells of the scanned row in merged regions.
+func (r *Reader) fill(cols []string) []string {
+	for _, mc := range r.merged {
+		if r.cursor < mc.top || r.cursor > mc.bottom {
+			continue
+		}
+		for len(cols) < mc.right {
+			cols = append(cols, "")
+		}
+		for col := mc.left; col <= mc.right; col++ {
+			cols[col-1] = mc.value
+		}
+	}
+	return cols
+}
+
+// anchor method returns name of anchor cell if cell is in merged region.
+func (r *Reader) anchor(col int) string {
+	if r.fillMerged {
+		for _, mc := range r.merged {
+			if r.rowNum >= mc.top && r.rowNum <= mc.bottom && col >= mc.left && col <= mc.right {
+				return mc.anchor
+			}
+		}
+	}
+	return csvdata.CellName(col, r.rowNum)
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,76 @@
+package exceldata_test
+
+import (
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/goark/csvdata/exceldata"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestFillMerged(t *testing.T) {
+	f := excelize.NewFile()
+	rows := map[string][]any{
+		"A1": {"name", "Q1"},
+		"A2": {"Earth", 1, 2},
+		"B3": {3},
+	}
+	for cell, row := range rows {
+		row := row
+		if err := f.SetSheetRow("Sheet1", cell, &row); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, ref := range [][2]string{{"B1", "D1"}, {"A2", "A3"}, {"C2", "D3"}} {
+		if err := f.MergeCell("Sheet1", ref[0], ref[1]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	testCases := []struct {
+		name string
+		fill bool
+		want [][]string
+	}{
+		{name: "", fill: false, want: [][]string{{"name", "Q1"}, {"Earth", "1", "2"}, {"", "3"}}},
+		{name: "", fill: true, want: [][]string{{"name", "Q1", "Q1", "Q1"}, {"Earth", "1", "2", "2"}, {"Earth", "3", "2", "2"}}},
+		{name: "A2:C3", fill: true, want: [][]string{{"Earth", "1", "2"}, {"Earth", "3", "2"}}},
+	}
+	for _, tc := range testCases {
+		r, err := exceldata.New(f, tc.name)
+		if err != nil {
+			t.Fatalf("New() is \"%+v\", want nil.", err)
+		}
+		r = r.WithFillMerged(tc.fill)
+		list := [][]string{}
+		for {
+			record, err := r.Read()
+			if err != nil {
+				if !errors.Is(err, io.EOF) {
+					t.Errorf("Read() is \"%+v\", want \"%+v\".", err, io.EOF)
+				}
+				break
+			}
+			list = append(list, record)
+		}
+		if !reflect.DeepEqual(list, tc.want) {
+			t.Errorf("WithFillMerged(%v) reads %q, want %q.", tc.fill, list, tc.want)
+		}
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,52 @@
+package exceldata
+
+import (
+	"io"
+	"io/fs"
+
+	"github.com/goark/errs"
+	"github.com/xuri/excelize/v2"
+)
+
+// OpenReader function returns Excel file instance from io.Reader (e.g. body of HTTP request). All data is read into memory.
+func OpenReader(r io.Reader, password string) (*excelize.File, error) {
+	xlsx, err := excelize.OpenReader(r, excelize.Options{Password: password})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return xlsx, nil
+}
+
+// OpenReaderAt function returns Excel file instance from data with size (e.g. multipart.File).
+func OpenReaderAt(r io.ReaderAt, size int64, password string) (*excelize.File, error) {
+	return OpenReader(io.NewSectionReader(r, 0, size), password)
+}
+
+// OpenFS function returns Excel file instance from file in fsys (e.g. embed.FS).
+func OpenFS(fsys fs.FS, name, password string) (*excelize.File, error) {
+	file, err := fsys.Open(name)
+	if err != nil {
+		return nil, errs.Wrap(err, errs.WithContext("name", name))
+	}
+	defer file.Close()
+	xlsx, err := OpenReader(file, password)
+	if err != nil {
+		return nil, errs.Wrap(err, errs.WithContext("name", name))
+	}
+	return xlsx, nil
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
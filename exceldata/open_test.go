@@ -0,0 +1,71 @@
+package exceldata_test
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"testing"
+	"testing/fstest"
+
+	"github.com/goark/csvdata"
+	"github.com/goark/csvdata/exceldata"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestOpenReader(t *testing.T) {
+	data, err := os.ReadFile("testdata/sample.xlsx")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fsys := fstest.MapFS{"sample.xlsx": &fstest.MapFile{Data: data}}
+	testCases := []struct {
+		name string
+		open func() (*excelize.File, error)
+	}{
+		{name: "OpenReader", open: func() (*excelize.File, error) {
+			return exceldata.OpenReader(struct{ io.Reader }{bytes.NewReader(data)}, "")
+		}},
+		{name: "OpenReaderAt", open: func() (*excelize.File, error) {
+			return exceldata.OpenReaderAt(bytes.NewReader(data), int64(len(data)), "")
+		}},
+		{name: "OpenFS", open: func() (*excelize.File, error) { return exceldata.OpenFS(fsys, "sample.xlsx", "") }},
+	}
+	for _, tc := range testCases {
+		xlsx, err := tc.open()
+		if err != nil {
+			t.Errorf("%s() is \"%+v\", want nil.", tc.name, err)
+			continue
+		}
+		r, err := exceldata.New(xlsx, "")
+		if err != nil {
+			t.Errorf("New() is \"%+v\", want nil.", err)
+			continue
+		}
+		rc := csvdata.NewRows(r, true)
+		if err := rc.Next(); err != nil {
+			t.Errorf("Next() is \"%+v\", want nil.", err)
+			continue
+		}
+		if name := rc.Column("name"); name != " Mercury" {
+			t.Errorf("%s() reads \"%v\", want \"%v\".", tc.name, name, " Mercury")
+		}
+	}
+	if _, err := exceldata.OpenFS(fsys, "none.xlsx", ""); err == nil {
+		t.Error("OpenFS() is nil, want error.")
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
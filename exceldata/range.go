@@ -0,0 +1,183 @@
+package exceldata
+
+import (
+	"encoding/xml"
+	"path"
+	"strings"
+
+	"github.com/goark/csvdata"
+	"github.com/goark/errs"
+	"github.com/xuri/excelize/v2"
+)
+
+// cellRange is rectangular area of cells (1-based, inclusive).
+type cellRange struct {
+	left, top, right, bottom int
+}
+
+// parseRange function parses A1-style range reference (e.g. "B4:H200", "$B$4:$H$200" or "B4").
+func parseRange(ref string) (*cellRange, error) {
+	ref = strings.ReplaceAll(ref, "$", "")
+	from, to, ok := strings.Cut(ref, ":")
+	if !ok {
+		to = from
+	}
+	left, top, err := excelize.CellNameToCoordinates(from)
+	if err != nil {
+		return nil, errs.Wrap(err, errs.WithContext("range", ref))
+	}
+	right, bottom, err := excelize.CellNameToCoordinates(to)
+	if err != nil {
+		return nil, errs.Wrap(err, errs.WithContext("range", ref))
+	}
+	if left > right {
+		left, right = right, left
+	}
+	if top > bottom {
+		top, bottom = bottom, top
+	}
+	return &cellRange{left: left, top: top, right: right, bottom: bottom}, nil
+}
+
+// splitSheetRef function splits reference such as "'Sheet 1'!$B$4:$H$200" into sheet name and range.
+func splitSheetRef(ref string) (string, string, bool) {
+	ref = strings.TrimPrefix(strings.TrimSpace(ref), "=")
+	i := strings.LastIndex(ref, "!")
+	if i < 0 {
+		return "", ref, false
+	}
+	sheet := ref[:i]
+	if len(sheet) > 1 && strings.HasPrefix(sheet, "'") && strings.HasSuffix(sheet, "'") {
+		sheet = strings.ReplaceAll(sheet[1:len(sheet)-1], "''", "'")
+	}
+	return sheet, ref[i+1:], true
+}
+
+// resolveRange function resolves name in New function into sheet name and range of cells (nil if whole sheet).
+func resolveRange(xlsx *excelize.File, name string) (string, *cellRange, error) {
+	if len(name) == 0 {
+		return xlsx.GetSheetName(0), nil, nil
+	}
+	if i, err := xlsx.GetSheetIndex(name); err == nil && i >= 0 {
+		return name, nil, nil
+	}
+	if sheet, ref, ok := splitSheetRef(name); ok {
+		rng, err := parseRange(ref)
+		return sheet, rng, errs.Wrap(err)
+	}
+	for _, dn := range xlsx.GetDefinedName() {
+		if dn.Name != name {
+			continue
+		}
+		sheet, ref, ok := splitSheetRef(dn.RefersTo)
+		if !ok {
+			return "", nil, errs.Wrap(csvdata.ErrInvalidSheetName, errs.WithContext("SheetName", name), errs.WithContext("RefersTo", dn.RefersTo))
+		}
+		rng, err := parseRange(ref)
+		return sheet, rng, errs.Wrap(err, errs.WithContext("SheetName", name))
+	}
+	if sheet, ref, ok := findTable(xlsx, name); ok {
+		rng, err := parseRange(ref)
+		return sheet, rng, errs.Wrap(err, errs.WithContext("SheetName", name))
+	}
+	if rng, err := parseRange(name); err == nil {
+		return xlsx.GetSheetName(0), rng, nil
+	}
+	return "", nil, errs.Wrap(csvdata.ErrInvalidSheetName, errs.WithContext("SheetName", name))
+}
+
+type xlsxRelationships struct {
+	Relationships []struct {
+		ID     string `xml:"Id,attr"`
+		Type   string `xml:"Type,attr"`
+		Target string `xml:"Target,attr"`
+	} `xml:"Relationship"`
+}
+
+// relTargets function returns paths of parts related from part by relationship type suffix.
+func relTargets(xlsx *excelize.File, part, typ string) map[string]string {
+	dir, file := path.Split(part)
+	rels := &xlsxRelationships{}
+	if err := xml.Unmarshal(readPart(xlsx, dir+"_rels/"+file+".rels"), rels); err != nil {
+		return nil
+	}
+	targets := map[string]string{}
+	for _, rel := range rels.Relationships {
+		if !strings.HasSuffix(rel.Type, typ) {
+			continue
+		}
+		if strings.HasPrefix(rel.Target, "/") {
+			targets[rel.ID] = strings.TrimPrefix(rel.Target, "/")
+		} else {
+			targets[rel.ID] = path.Join(dir, rel.Target)
+		}
+	}
+	return targets
+}
+
+func readPart(xlsx *excelize.File, name string) []byte {
+	if v, ok := xlsx.Pkg.Load(name); ok {
+		if b, ok := v.([]byte); ok {
+			return b
+		}
+	}
+	return nil
+}
+
+// sheetParts function returns paths of worksheet parts by sheet name.
+func sheetParts(xlsx *excelize.File) map[string]string {
+	parts := map[string]string{}
+	for _, wbPath := range relTargets(xlsx, "", "/officeDocument") {
+		wb := &struct {
+			Sheets []struct {
+				Name string `xml:"name,attr"`
+				ID   string `xml:"id,attr"`
+			} `xml:"sheets>sheet"`
+		}{}
+		if err := xml.Unmarshal(readPart(xlsx, wbPath), wb); err != nil {
+			continue
+		}
+		sheets := relTargets(xlsx, wbPath, "/worksheet")
+		for _, sheet := range wb.Sheets {
+			if sheetPath, ok := sheets[sheet.ID]; ok {
+				parts[sheet.Name] = sheetPath
+			}
+		}
+	}
+	return parts
+}
+
+// findTable function returns sheet name and range of Excel table (ListObject) by name.
+func findTable(xlsx *excelize.File, name string) (string, string, bool) {
+	for sheet, sheetPath := range sheetParts(xlsx) {
+		for _, tablePath := range relTargets(xlsx, sheetPath, "/table") {
+			table := &struct {
+				Name        string `xml:"name,attr"`
+				DisplayName string `xml:"displayName,attr"`
+				Ref         string `xml:"ref,attr"`
+			}{}
+			if err := xml.Unmarshal(readPart(xlsx, tablePath), table); err != nil {
+				continue
+			}
+			if table.Name == name || table.DisplayName == name {
+				return sheet, table.Ref, true
+			}
+		}
+	}
+	return "", "", false
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
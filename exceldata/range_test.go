@@ -0,0 +1,134 @@
+package exceldata_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/goark/csvdata"
+	"github.com/goark/csvdata/exceldata"
+	"github.com/xuri/excelize/v2"
+)
+
+func rangeFile(t *testing.T) *excelize.File {
+	t.Helper()
+	f := excelize.NewFile()
+	_ = f.SetSheetName("Sheet1", "Solar System")
+	rows := map[string][]any{
+		"A1": {"Planets"},
+		"B3": {"order", "name", "mass", nil, "note"},
+		"B4": {1, "Mercury", 0.055, nil, "A"},
+		"B5": {2, "Venus", 0.815, nil, "B"},
+		"B6": {3, "Earth", 1, nil, nil},
+		"B8": {"total", 3},
+	}
+	for cell, row := range rows {
+		row := row
+		if err := f.SetSheetRow("Solar System", cell, &row); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := f.SetDefinedName(&excelize.DefinedName{Name: "Planets", RefersTo: "'Solar System'!$B$3:$D$6"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.AddTable("Solar System", "B3:D6", &excelize.TableOptions{Name: "PlanetTable"}); err != nil {
+		t.Fatal(err)
+	}
+	buf := &bytes.Buffer{}
+	if err := f.Write(buf); err != nil {
+		t.Fatal(err)
+	}
+	xlsx, err := excelize.OpenReader(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return xlsx
+}
+
+func TestRange(t *testing.T) {
+	xlsx := rangeFile(t)
+	want := [][]string{
+		{"order", "name", "mass"},
+		{"1", "Mercury", "0.055"},
+		{"2", "Venus", "0.815"},
+		{"3", "Earth", "1"},
+	}
+	testCases := []struct {
+		name string
+		want [][]string
+	}{
+		{name: "B3:D6", want: want},
+		{name: "'Solar System'!$B$3:$D$6", want: want},
+		{name: "Solar System!D6:B3", want: want},
+		{name: "Planets", want: want},
+		{name: "PlanetTable", want: want},
+		{name: "E5:G6", want: [][]string{{"", "B", ""}, {"", "", ""}}},
+		{name: "B8:C100", want: [][]string{{"total", "3"}}},
+	}
+	for _, tc := range testCases {
+		r, err := exceldata.New(xlsx, tc.name)
+		if err != nil {
+			t.Errorf("New(%q) is \"%+v\", want nil.", tc.name, err)
+			continue
+		}
+		list := [][]string{}
+		for {
+			record, err := r.Read()
+			if err != nil {
+				if !errors.Is(err, io.EOF) {
+					t.Errorf("Read() is \"%+v\", want \"%+v\".", err, io.EOF)
+				}
+				break
+			}
+			list = append(list, record)
+		}
+		if !reflect.DeepEqual(list, tc.want) {
+			t.Errorf("New(%q) reads %q, want %q.", tc.name, list, tc.want)
+		}
+	}
+}
+
+func TestRangePosition(t *testing.T) {
+	r, err := exceldata.New(rangeFile(t), "Planets")
+	if err != nil {
+		t.Fatalf("New() is \"%+v\", want nil.", err)
+	}
+	rc := csvdata.NewRows(r, true)
+	defer rc.Close() //dummy
+	if err := rc.Next(); err != nil {
+		t.Fatalf("Next() is \"%+v\", want nil.", err)
+	}
+	_, err = rc.ColumnInt64("name", 10)
+	var fe *csvdata.FieldError
+	if !errors.As(err, &fe) {
+		t.Fatalf("ColumnInt64() is \"%+v\", want *csvdata.FieldError.", err)
+	}
+	if fe.Cell != "C4" || fe.Line != 4 || fe.Column != 3 {
+		t.Errorf("FieldError is %+v, want cell C4.", fe)
+	}
+}
+
+func TestRangeError(t *testing.T) {
+	for _, name := range []string{"Unknown", "Unknown!A1:B2"} {
+		if _, err := exceldata.New(rangeFile(t), name); !errors.Is(err, csvdata.ErrInvalidSheetName) {
+			t.Errorf("New(%q) is \"%+v\", want \"%+v\".", name, err, csvdata.ErrInvalidSheetName)
+		}
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,76 @@
+package exceldata_test
+
+import (
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/goark/csvdata"
+	"github.com/goark/csvdata/exceldata"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestWithTrim(t *testing.T) {
+	f := excelize.NewFile()
+	for cell, value := range map[string]any{"A1": "name", "C1": "", "A2": "Earth", "B2": 1, "A4": "Mars"} {
+		if err := f.SetCellValue("Sheet1", cell, value); err != nil {
+			t.Fatal(err)
+		}
+	}
+	style, err := f.NewStyle(&excelize.Style{Font: &excelize.Font{Bold: true}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := f.SetCellStyle("Sheet1", "A6", "D6", style); err != nil {
+		t.Fatal(err)
+	}
+	testCases := []struct {
+		mode csvdata.TrimMode
+		want [][]string
+		line int
+	}{
+		{mode: 0, want: [][]string{{"name", "", "", ""}, {"Earth", "1", "", ""}, {"", "", "", ""}, {"Mars", "", "", ""}, {"", "", "", ""}, {"", "", "", ""}}, line: 6},
+		{mode: csvdata.TrimTrailingRows | csvdata.TrimTrailingColumns, want: [][]string{{"name"}, {"Earth", "1"}, {}, {"Mars"}}, line: 4},
+		{mode: csvdata.TrimAll, want: [][]string{{"name"}, {"Earth", "1"}, {"Mars"}}, line: 4},
+	}
+	for _, tc := range testCases {
+		r, err := exceldata.New(f, "A1:D8")
+		if err != nil {
+			t.Fatalf("New() is \"%+v\", want nil.", err)
+		}
+		r = r.WithTrim(tc.mode)
+		list := [][]string{}
+		for {
+			record, err := r.Read()
+			if err != nil {
+				if !errors.Is(err, io.EOF) {
+					t.Errorf("Read() is \"%+v\", want \"%+v\".", err, io.EOF)
+				}
+				break
+			}
+			list = append(list, record)
+		}
+		if !reflect.DeepEqual(list, tc.want) {
+			t.Errorf("WithTrim(%v) reads %q, want %q.", tc.mode, list, tc.want)
+		}
+		if pos := r.Position(0); pos.Line != tc.line {
+			t.Errorf("Position() is %+v, want line %v.", pos, tc.line)
+		}
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,136 @@
+package exceldata
+
+import (
+	"bytes"
+	"encoding/xml"
+	"iter"
+
+	"github.com/goark/csvdata"
+	"github.com/goark/errs"
+	"github.com/xuri/excelize/v2"
+)
+
+// Workbook is class of Excel workbook for reading all sheets.
+type Workbook struct {
+	xlsx *excelize.File
+}
+
+var _ csvdata.Workbook = (*Workbook)(nil) //Workbook is compatible with csvdata.Workbook interface
+
+// NewWorkbook function creates a new Workbook instance.
+func NewWorkbook(xlsx *excelize.File) *Workbook {
+	return &Workbook{xlsx: xlsx}
+}
+
+// Sheets method returns metadata of sheets in workbook.
+// Rows and Columns are taken from dimension of sheet, or counted by scanning the sheet if dimension is missing or a single cell.
+func (w *Workbook) Sheets() ([]csvdata.SheetInfo, error) {
+	if w == nil || w.xlsx == nil {
+		return nil, errs.Wrap(csvdata.ErrNullPointer)
+	}
+	parts := sheetParts(w.xlsx)
+	list := []csvdata.SheetInfo{}
+	for i, name := range w.xlsx.GetSheetList() {
+		visible, err := w.xlsx.GetSheetVisible(name)
+		if err != nil {
+			return nil, errs.Wrap(err, errs.WithContext("SheetName", name))
+		}
+		info := csvdata.SheetInfo{Name: name, Index: i, Visible: visible}
+		if rng := dimension(w.xlsx, parts[name]); rng != nil && (rng.left != rng.right || rng.top != rng.bottom) {
+			info.Rows, info.Columns = rng.bottom, rng.right
+		} else if info.Rows, info.Columns, err = scanSize(w.xlsx, name); err != nil {
+			return nil, errs.Wrap(err, errs.WithContext("SheetName", name))
+		}
+		list = append(list, info)
+	}
+	return list, nil
+}
+
+// OpenSheet method returns Reader instance for the sheet. It is the same as New function.
+func (w *Workbook) OpenSheet(name string) (csvdata.RowsReader, error) {
+	if w == nil {
+		return nil, errs.Wrap(csvdata.ErrNullPointer)
+	}
+	r, err := New(w.xlsx, name)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return r, nil
+}
+
+// All method returns iterator over all sheets in workbook. (see csvdata.AllSheets function)
+func (w *Workbook) All(headerFlag bool) iter.Seq2[*csvdata.SheetRows, error] {
+	return csvdata.AllSheets(w, headerFlag)
+}
+
+// scanSize function counts rows and columns in used range of sheet.
+func scanSize(xlsx *excelize.File, name string) (int, int, error) {
+	rows, err := xlsx.Rows(name)
+	if err != nil {
+		return 0, 0, errs.Wrap(err)
+	}
+	defer rows.Close()
+	n, height, width := 0, 0, 0
+	for rows.Next() {
+		n++
+		cols, err := rows.Columns(excelize.Options{RawCellValue: true})
+		if err != nil {
+			return 0, 0, errs.Wrap(err)
+		}
+		for len(cols) > 0 && len(cols[len(cols)-1]) == 0 {
+			cols = cols[:len(cols)-1]
+		}
+		if len(cols) > 0 {
+			height = n
+			if width < len(cols) {
+				width = len(cols)
+			}
+		}
+	}
+	return height, width, errs.Wrap(rows.Error())
+}
+
+// dimension function returns used range of worksheet part from dimension element (nil if unknown).
+func dimension(xlsx *excelize.File, part string) *cellRange {
+	data := readPart(xlsx, part)
+	if len(data) == 0 {
+		return nil
+	}
+	d := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return nil
+		}
+		if se, ok := token.(xml.StartElement); ok {
+			switch se.Name.Local {
+			case "dimension":
+				for _, attr := range se.Attr {
+					if attr.Name.Local == "ref" {
+						if rng, err := parseRange(attr.Value); err == nil {
+							return rng
+						}
+					}
+				}
+				return nil
+			case "sheetData":
+				return nil
+			}
+		}
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,84 @@
+package exceldata_test
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/goark/csvdata"
+	"github.com/goark/csvdata/exceldata"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestWorkbook(t *testing.T) {
+	f := excelize.NewFile()
+	if _, err := f.NewSheet("Feb"); err != nil {
+		t.Fatal(err)
+	}
+	_ = f.SetSheetName("Sheet1", "Jan")
+	for sheet, rows := range map[string][][]any{
+		"Jan": {{"name", "amount"}, {"Earth", 1}},
+		"Feb": {{"name", "amount"}, {"Mars", 2}, {"Venus", 3}},
+	} {
+		for i, row := range rows {
+			row := row
+			cell, _ := excelize.CoordinatesToCellName(1, i+1)
+			if err := f.SetSheetRow(sheet, cell, &row); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	if err := f.SetSheetVisible("Feb", false); err != nil {
+		t.Fatal(err)
+	}
+	buf := &bytes.Buffer{}
+	if err := f.Write(buf); err != nil {
+		t.Fatal(err)
+	}
+	xlsx, err := excelize.OpenReader(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wb := exceldata.NewWorkbook(xlsx)
+	sheets, err := wb.Sheets()
+	if err != nil {
+		t.Fatalf("Sheets() is \"%+v\", want nil.", err)
+	}
+	want := []csvdata.SheetInfo{
+		{Name: "Jan", Index: 0, Visible: true, Rows: 2, Columns: 2},
+		{Name: "Feb", Index: 1, Visible: false, Rows: 3, Columns: 2},
+	}
+	if !reflect.DeepEqual(sheets, want) {
+		t.Errorf("Sheets() is %+v, want %+v.", sheets, want)
+	}
+	names := []string{}
+	for sheet, err := range wb.All(true) {
+		if err != nil {
+			t.Fatalf("All() yields \"%+v\", want nil.", err)
+		}
+		for _, row := range sheet.All() {
+			names = append(names, sheet.Name+":"+row.Column("name"))
+		}
+		if err := sheet.Err(); err != nil {
+			t.Errorf("Err() is \"%+v\", want nil.", err)
+		}
+	}
+	if !reflect.DeepEqual(names, []string{"Jan:Earth", "Feb:Mars", "Feb:Venus"}) {
+		t.Errorf("All() reads %q.", names)
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,67 @@
+package exceldata
+
+import (
+	"github.com/goark/csvdata"
+	"github.com/goark/errs"
+	"github.com/xuri/excelize/v2"
+)
+
+// Writer is class of Excel data writer
+type Writer struct {
+	xlsx  *excelize.File
+	sheet string
+	row   int
+}
+
+var _ csvdata.RowsWriter = (*Writer)(nil) //Writer is compatible with csvdata.RowsWriter interface
+
+// NewWriter function creates a new Writer instance. If the sheet does not exist, it is created.
+// All cells are written as string values, so that these are read by Reader as is.
+func NewWriter(xlsx *excelize.File, sheetName string) (*Writer, error) {
+	if xlsx == nil {
+		return nil, errs.Wrap(csvdata.ErrNullPointer)
+	}
+	if len(sheetName) == 0 {
+		sheetName = xlsx.GetSheetName(0)
+	}
+	if _, err := xlsx.NewSheet(sheetName); err != nil {
+		return nil, errs.Wrap(csvdata.ErrInvalidSheetName, errs.WithCause(err), errs.WithContext("SheetName", sheetName))
+	}
+	return &Writer{xlsx: xlsx, sheet: sheetName}, nil
+}
+
+// Write method writes a record to next row.
+func (w *Writer) Write(record []string) error {
+	if w == nil || w.xlsx == nil {
+		return errs.Wrap(csvdata.ErrNullPointer)
+	}
+	w.row++
+	cell, err := excelize.CoordinatesToCellName(1, w.row)
+	if err != nil {
+		return errs.Wrap(err, errs.WithContext("row", w.row))
+	}
+	if err := w.xlsx.SetSheetRow(w.sheet, cell, &record); err != nil {
+		return errs.Wrap(err, errs.WithContext("SheetName", w.sheet), errs.WithContext("cell", cell))
+	}
+	return nil
+}
+
+// Close method is dummy. Save excelize.File instance by yourself.
+func (w *Writer) Close() error {
+	return nil
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,97 @@
+package exceldata_test
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+
+	"github.com/goark/csvdata"
+	"github.com/goark/csvdata/exceldata"
+	"github.com/xuri/excelize/v2"
+)
+
+type planet struct {
+	Order int     `csv:"order"`
+	Name  string  `csv:"name"`
+	Mass  float64 `csv:"mass"`
+	Note  string  `csv:"note"`
+}
+
+type nullable struct {
+	Name  string          `csv:"name"`
+	Flag  sql.NullBool    `csv:"flag"`
+	Count sql.NullInt64   `csv:"count"`
+	Mass  sql.NullFloat64 `csv:"mass"`
+	Note  sql.NullString  `csv:"note"`
+}
+
+var nullableList = []nullable{
+	{Name: "Earth"},
+	{Name: "Mars", Flag: sql.NullBool{Bool: false, Valid: true}, Count: sql.NullInt64{Int64: 0, Valid: true}, Mass: sql.NullFloat64{Float64: 0.107, Valid: true}, Note: sql.NullString{String: "red", Valid: true}},
+}
+
+func TestWriter(t *testing.T) {
+	list := []planet{{Order: 1, Name: "Mercury", Mass: 0.055, Note: " the first\nplanet "}, {Order: 2, Name: "Venus", Mass: 0.815}}
+	xlsx := excelize.NewFile()
+	w, err := exceldata.NewWriter(xlsx, "planets")
+	if err != nil {
+		t.Fatalf("NewWriter() is \"%+v\", want nil.", err)
+	}
+	if err := csvdata.Encode(w, list); err != nil {
+		t.Fatalf("Encode() is \"%+v\", want nil.", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close() is \"%+v\", want nil.", err)
+	}
+	r, err := exceldata.New(xlsx, "planets")
+	if err != nil {
+		t.Fatalf("New() is \"%+v\", want nil.", err)
+	}
+	got, err := csvdata.DecodeAll[planet](csvdata.NewRows(r, true))
+	if err != nil {
+		t.Fatalf("DecodeAll() is \"%+v\", want nil.", err)
+	}
+	if !reflect.DeepEqual(got, list) {
+		t.Errorf("DecodeAll() is %+v, want %+v.", got, list)
+	}
+}
+
+func TestWriterNull(t *testing.T) {
+	xlsx := excelize.NewFile()
+	w, err := exceldata.NewWriter(xlsx, "planets")
+	if err != nil {
+		t.Fatalf("NewWriter() is \"%+v\", want nil.", err)
+	}
+	if err := csvdata.Encode(w, nullableList); err != nil {
+		t.Fatalf("Encode() is \"%+v\", want nil.", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close() is \"%+v\", want nil.", err)
+	}
+	r, err := exceldata.New(xlsx, "planets")
+	if err != nil {
+		t.Fatalf("New() is \"%+v\", want nil.", err)
+	}
+	got, err := csvdata.DecodeAll[nullable](csvdata.NewRows(r, true))
+	if err != nil {
+		t.Fatalf("DecodeAll() is \"%+v\", want nil.", err)
+	}
+	if !reflect.DeepEqual(got, nullableList) {
+		t.Errorf("DecodeAll() is %+v, want %+v.", got, nullableList)
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,178 @@
+package csvdata
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/goark/errs"
+)
+
+// DefaultHeaderJoiner is joiner of compound names in multi-row header.
+const DefaultHeaderJoiner = "/"
+
+// WithHeaderRows method sets number of header rows (default 1). Names in multi-row header are combined into compound names (e.g. "2023/Q1/Revenue").
+// Empty names in upper rows are filled by names in the left column (as merged cells), and empty names are omitted from compound names.
+func (r *Rows) WithHeaderRows(n int) *Rows {
+	if r == nil {
+		return nil
+	}
+	r.headerRows = n
+	return r
+}
+
+// WithHeaderJoiner method sets joiner of compound names in multi-row header. (default: DefaultHeaderJoiner)
+func (r *Rows) WithHeaderJoiner(joiner string) *Rows {
+	if r == nil {
+		return nil
+	}
+	r.headerJoiner = joiner
+	return r
+}
+
+// HeaderPath method returns compound name of column by names in each header row. (e.g. rc.Column(rc.HeaderPath("2023", "Q1", "Revenue")))
+func (r *Rows) HeaderPath(names ...string) string {
+	parts := make([]string, 0, len(names))
+	for _, name := range names {
+		if name = strings.TrimSpace(name); len(name) > 0 {
+			parts = append(parts, name)
+		}
+	}
+	return strings.Join(parts, r.joiner())
+}
+
+func (r *Rows) joiner() string {
+	if r == nil || len(r.headerJoiner) == 0 {
+		return DefaultHeaderJoiner
+	}
+	return r.headerJoiner
+}
+
+// readHeader method reads header rows and combines them.
+func (r *Rows) readHeader() ([]string, error) {
+	n := r.headerRows
+	if n < 1 {
+		n = 1
+	}
+	rows := make([][]string, 0, n)
+	for len(rows) < n {
+		row, err := r.reader.Read()
+		if err != nil {
+			if len(rows) == 0 {
+				return row, err
+			}
+			return combineHeader(rows, r.joiner()), err
+		}
+		rows = append(rows, append([]string{}, row...))
+	}
+	if n == 1 {
+		return rows[0], nil
+	}
+	return combineHeader(rows, r.joiner()), nil
+}
+
+// combineHeader function combines multi-row header into compound names.
+func combineHeader(rows [][]string, joiner string) []string {
+	width := 0
+	for _, row := range rows {
+		if width < len(row) {
+			width = len(row)
+		}
+	}
+	upper := make([]string, len(rows)-1) // names of upper rows in the left column
+	header := make([]string, width)
+	for i := 0; i < width; i++ {
+		parts := []string{}
+		inherit := true
+		for t, row := range rows {
+			name := ""
+			if i < len(row) {
+				name = strings.TrimSpace(row[i])
+			}
+			if t < len(upper) {
+				if len(name) == 0 && inherit {
+					name = upper[t]
+				} else {
+					inherit = false
+				}
+				upper[t] = name
+			}
+			if len(name) > 0 {
+				parts = append(parts, name)
+			}
+		}
+		header[i] = strings.Join(parts, joiner)
+	}
+	return header
+}
+
+// DuplicatePolicy is policy for duplicate and empty names in header.
+type DuplicatePolicy int
+
+const (
+	DuplicateKeepLast  DuplicatePolicy = iota // lookup finds the last column of the same name (default)
+	DuplicateKeepFirst                        // lookup finds the first column of the same name
+	DuplicateRename                           // renames duplicate names with suffix (e.g. "name_2"), and empty names by column number (e.g. "column_3")
+	DuplicateError                            // Header and Next methods return ErrDuplicateHeader or ErrEmptyHeader
+)
+
+// WithDuplicateHeader method sets policy for duplicate and empty names in header.
+// Names are compared after normalization by HeaderNormalizer.
+func (r *Rows) WithDuplicateHeader(p DuplicatePolicy) *Rows {
+	if r == nil {
+		return nil
+	}
+	r.duplicate = p
+	return r
+}
+
+// buildHeaderMap method makes map of header names to indexes by DuplicatePolicy.
+func (r *Rows) buildHeaderMap() error {
+	for i, name := range r.headerStrings {
+		key := r.normalize(name)
+		j, dup := r.headerMap[key]
+		switch r.duplicate {
+		case DuplicateError:
+			if len(key) == 0 {
+				return errs.Wrap(ErrEmptyHeader, errs.WithContext("index", i))
+			}
+			if dup {
+				return errs.Wrap(ErrDuplicateHeader, errs.WithContext("name", strings.TrimSpace(name)), errs.WithContext("index", i), errs.WithContext("first", j))
+			}
+		case DuplicateKeepFirst:
+			if dup {
+				continue
+			}
+		case DuplicateRename:
+			if len(key) == 0 {
+				name = "column_" + strconv.Itoa(i+1)
+				key = r.normalize(name)
+				_, dup = r.headerMap[key]
+			}
+			base := strings.TrimSpace(name)
+			for n := 2; dup; n++ {
+				name = base + "_" + strconv.Itoa(n)
+				key = r.normalize(name)
+				_, dup = r.headerMap[key]
+			}
+			r.headerStrings[i] = name
+		}
+		r.headerMap[key] = i
+	}
+	r.buildLookup()
+	return nil
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,126 @@
+package csvdata_test
+
+import (
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/goark/csvdata"
+)
+
+const csvMultiHeader = `,2023,,,2024
+,Q1,,Q2,Q1
+name,Revenue,Cost,Revenue,Revenue
+Earth,100,80,120,130
+`
+
+func TestMultiRowHeader(t *testing.T) {
+	testCases := []struct {
+		joiner string
+		want   []string
+	}{
+		{joiner: "", want: []string{"name", "2023/Q1/Revenue", "2023/Q1/Cost", "2023/Q2/Revenue", "2024/Q1/Revenue"}},
+		{joiner: ".", want: []string{"name", "2023.Q1.Revenue", "2023.Q1.Cost", "2023.Q2.Revenue", "2024.Q1.Revenue"}},
+	}
+	for _, tc := range testCases {
+		rc := csvdata.NewRows(csvdata.New(strings.NewReader(csvMultiHeader)), true).WithHeaderRows(3).WithHeaderJoiner(tc.joiner)
+		header, err := rc.Header()
+		if err != nil {
+			t.Errorf("Header() is \"%+v\", want nil.", err)
+			continue
+		}
+		if !reflect.DeepEqual(header, tc.want) {
+			t.Errorf("Header() is %q, want %q.", header, tc.want)
+		}
+		if err := rc.Next(); err != nil {
+			t.Errorf("Next() is \"%+v\", want nil.", err)
+			continue
+		}
+		if n, err := rc.ColumnInt64(rc.HeaderPath("2023", "Q2", "Revenue"), 10); err != nil || n != 120 {
+			t.Errorf("ColumnInt64() is %v, \"%+v\", want %v.", n, err, 120)
+		}
+		if s := rc.Column(rc.HeaderPath("", "", "name")); s != "Earth" {
+			t.Errorf("Column() is %q, want %q.", s, "Earth")
+		}
+		if err := rc.Next(); !errors.Is(err, io.EOF) {
+			t.Errorf("Next() is \"%+v\", want \"%+v\".", err, io.EOF)
+		}
+	}
+}
+
+func TestMultiRowHeaderEOF(t *testing.T) {
+	rc := csvdata.NewRows(csvdata.New(strings.NewReader("a,b\n")), true).WithHeaderRows(2)
+	header, err := rc.Header()
+	if !errors.Is(err, io.EOF) {
+		t.Errorf("Header() is \"%+v\", want \"%+v\".", err, io.EOF)
+	}
+	if !reflect.DeepEqual(header, []string{"a", "b"}) {
+		t.Errorf("Header() is %q, want %q.", header, []string{"a", "b"})
+	}
+}
+
+func TestDuplicateHeader(t *testing.T) {
+	const input = "name,mass,Name,,name\nEarth,1,Terra,x,Tellus\n"
+	testCases := []struct {
+		policy csvdata.DuplicatePolicy
+		header []string
+		name   string
+		err    error
+	}{
+		{policy: csvdata.DuplicateKeepLast, header: []string{"name", "mass", "Name", "", "name"}, name: "Tellus"},
+		{policy: csvdata.DuplicateKeepFirst, header: []string{"name", "mass", "Name", "", "name"}, name: "Earth"},
+		{policy: csvdata.DuplicateRename, header: []string{"name", "mass", "Name_2", "column_4", "name_3"}, name: "Earth"},
+		{policy: csvdata.DuplicateError, header: []string{"name", "mass", "Name", "", "name"}, err: csvdata.ErrDuplicateHeader},
+	}
+	for _, tc := range testCases {
+		rc := csvdata.NewRows(csvdata.New(strings.NewReader(input)), true).WithHeaderNormalizer(csvdata.FoldCase).WithDuplicateHeader(tc.policy)
+		header, err := rc.Header()
+		if !errors.Is(err, tc.err) {
+			t.Errorf("Header() is \"%+v\", want \"%+v\".", err, tc.err)
+		}
+		if !reflect.DeepEqual(header, tc.header) {
+			t.Errorf("Header() is %q, want %q.", header, tc.header)
+		}
+		if err := rc.Next(); !errors.Is(err, tc.err) {
+			t.Errorf("Next() is \"%+v\", want \"%+v\".", err, tc.err)
+		}
+		if tc.err != nil {
+			continue
+		}
+		if s := rc.Column("name"); s != tc.name {
+			t.Errorf("Column() is %q, want %q.", s, tc.name)
+		}
+		if tc.policy == csvdata.DuplicateRename {
+			if s := rc.Column("name_3"); s != "Tellus" {
+				t.Errorf("Column() is %q, want %q.", s, "Tellus")
+			}
+			if s := rc.Column("column_4"); s != "x" {
+				t.Errorf("Column() is %q, want %q.", s, "x")
+			}
+		}
+	}
+}
+
+func TestEmptyHeader(t *testing.T) {
+	rc := csvdata.NewRows(csvdata.New(strings.NewReader("name,,mass\nEarth,x,1\n")), true).WithDuplicateHeader(csvdata.DuplicateError)
+	if _, err := rc.Header(); !errors.Is(err, csvdata.ErrEmptyHeader) {
+		t.Errorf("Header() is \"%+v\", want \"%+v\".", err, csvdata.ErrEmptyHeader)
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,176 @@
+package csvdata
+
+import (
+	"io"
+	"strings"
+	"time"
+
+	"github.com/goark/errs"
+)
+
+// InferTimeLayouts is list of candidate layouts for detecting TypeTime column in Infer function.
+var InferTimeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+	"2006/01/02 15:04:05",
+	"2006/01/02",
+	"01/02/2006",
+	time.RFC1123Z,
+	time.RFC1123,
+	"15:04:05",
+}
+
+// InferMaxCardinality is maximum number of distinct values counted for each column in Infer function.
+// Cardinality of ColumnProfile stops at this number, so that memory for profiling is bounded.
+var InferMaxCardinality = 10000
+
+// ColumnProfile is result of profiling a column.
+type ColumnProfile struct {
+	Name        string
+	Type        ColumnType // proposed type of column
+	Layout      string     // detected layout (TypeTime only)
+	Count       int        // number of inspected values
+	Nulls       int        // number of empty values
+	Cardinality int        // number of distinct non-empty values (up to InferMaxCardinality)
+}
+
+// NullRatio method returns ratio of empty values.
+func (p ColumnProfile) NullRatio() float64 {
+	if p.Count == 0 {
+		return 0
+	}
+	return float64(p.Nulls) / float64(p.Count)
+}
+
+// ColumnSchema method returns ColumnSchema instance proposed by the profile.
+func (p ColumnProfile) ColumnSchema() ColumnSchema {
+	return ColumnSchema{Name: p.Name, Type: p.Type, Required: true, Nullable: p.Nulls > 0, Layout: p.Layout}
+}
+
+// Profile is result of Infer function.
+type Profile struct {
+	Rows    int // number of inspected rows
+	Columns []ColumnProfile
+}
+
+// Schema method returns Schema instance proposed by the profile.
+func (p *Profile) Schema() *Schema {
+	if p == nil {
+		return nil
+	}
+	s := &Schema{Columns: make([]ColumnSchema, len(p.Columns))}
+	for i, c := range p.Columns {
+		s.Columns[i] = c.ColumnSchema()
+	}
+	return s
+}
+
+// columnGuess is candidates of column type in profiling.
+type columnGuess struct {
+	isInt, isFloat, isBool bool
+	layouts                []string
+	values                 map[string]bool
+}
+
+// Infer function reads header and up to n rows (all rows if n <= 0) from rr, and proposes type of each column.
+// Values are parsed by the same rules as Rows.GetXxx methods. Infer function does not close rr.
+func Infer(rr RowsReader, n int) (*Profile, error) {
+	if rr == nil {
+		return nil, errs.Wrap(ErrNullPointer)
+	}
+	r := NewRows(rr, true)
+	header, err := r.Header()
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	p := &Profile{Columns: make([]ColumnProfile, len(header))}
+	guesses := make([]columnGuess, len(header))
+	for i, name := range header {
+		p.Columns[i].Name = strings.TrimSpace(name)
+		guesses[i] = columnGuess{isInt: true, isFloat: true, isBool: true, layouts: InferTimeLayouts, values: map[string]bool{}}
+	}
+	for n <= 0 || p.Rows < n {
+		if err := r.Next(); err != nil {
+			if errs.Is(err, io.EOF) {
+				break
+			}
+			return nil, errs.Wrap(err)
+		}
+		p.Rows++
+		for i := range p.Columns {
+			r.inferColumn(i, &p.Columns[i], &guesses[i])
+		}
+	}
+	for i := range p.Columns {
+		c, g := &p.Columns[i], &guesses[i]
+		c.Cardinality = len(g.values)
+		switch {
+		case c.Count == c.Nulls:
+			c.Type = TypeString
+		case g.isInt:
+			c.Type = TypeInt
+		case g.isFloat:
+			c.Type = TypeFloat
+		case g.isBool:
+			c.Type = TypeBool
+		case len(g.layouts) > 0:
+			c.Type, c.Layout = TypeTime, g.layouts[0]
+		default:
+			c.Type = TypeString
+		}
+	}
+	return p, nil
+}
+
+func (r *Rows) inferColumn(i int, c *ColumnProfile, g *columnGuess) {
+	c.Count++
+	if r.isNull(i) {
+		c.Nulls++
+		return
+	}
+	if len(g.values) < InferMaxCardinality {
+		s, _ := r.GetString(i)
+		g.values[s] = true
+	}
+	if cell, ok := r.cell(i); ok && cell.Type == CellDate {
+		// serial number of date cell is not a numeric value
+		g.isInt, g.isFloat, g.isBool = false, false, false
+	}
+	if g.isInt {
+		_, err := r.GetInt64(i, 10)
+		g.isInt = err == nil
+	}
+	if g.isFloat {
+		_, err := r.GetFloat64(i)
+		g.isFloat = err == nil
+	}
+	if g.isBool {
+		_, err := r.GetBool(i)
+		g.isBool = err == nil
+	}
+	if len(g.layouts) > 0 {
+		layouts := []string{}
+		for _, layout := range g.layouts {
+			if _, err := r.GetTime(i, layout); err == nil {
+				layouts = append(layouts, layout)
+			}
+		}
+		g.layouts = layouts
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,109 @@
+package csvdata_test
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/goark/csvdata"
+)
+
+const csvInfer = `id,name,mass,habitable,date,memo
+1,Mercury,0.055,false,2023-01-01,
+2,Venus,0.815,false,2023-01-02,
+3,Earth,1,true,2023-01-02,home
+4,Mars,,false,2023/01/04,
+`
+
+func TestInfer(t *testing.T) {
+	testCases := []struct {
+		n     int
+		rows  int
+		types []csvdata.ColumnType
+		nulls []int
+		cards []int
+	}{
+		{n: 0, rows: 4, types: []csvdata.ColumnType{csvdata.TypeInt, csvdata.TypeString, csvdata.TypeFloat, csvdata.TypeBool, csvdata.TypeString, csvdata.TypeString}, nulls: []int{0, 0, 1, 0, 0, 3}, cards: []int{4, 4, 3, 2, 3, 1}},
+		{n: 3, rows: 3, types: []csvdata.ColumnType{csvdata.TypeInt, csvdata.TypeString, csvdata.TypeFloat, csvdata.TypeBool, csvdata.TypeTime, csvdata.TypeString}, nulls: []int{0, 0, 0, 0, 0, 2}, cards: []int{3, 3, 3, 2, 2, 1}},
+	}
+	for _, tc := range testCases {
+		p, err := csvdata.Infer(csvdata.New(strings.NewReader(csvInfer)), tc.n)
+		if err != nil {
+			t.Errorf("Infer() is \"%+v\", want nil.", err)
+			continue
+		}
+		if p.Rows != tc.rows || len(p.Columns) != len(tc.types) {
+			t.Errorf("Infer(%v) is %+v, want %v rows.", tc.n, p, tc.rows)
+			continue
+		}
+		for i, c := range p.Columns {
+			if c.Type != tc.types[i] || c.Nulls != tc.nulls[i] || c.Cardinality != tc.cards[i] || c.Count != tc.rows {
+				t.Errorf("Infer(%v).Columns[%d] is %+v, want {Type:%v Nulls:%v Cardinality:%v}.", tc.n, i, c, tc.types[i], tc.nulls[i], tc.cards[i])
+			}
+		}
+		if tc.n == 3 {
+			if c := p.Columns[4]; c.Layout != "2006-01-02" {
+				t.Errorf("Layout is %q, want %q.", c.Layout, "2006-01-02")
+			}
+			if r := p.Columns[5].NullRatio(); r != 2.0/3.0 {
+				t.Errorf("NullRatio() is %v, want %v.", r, 2.0/3.0)
+			}
+		}
+	}
+}
+
+func TestInferSchema(t *testing.T) {
+	p, err := csvdata.Infer(csvdata.New(strings.NewReader(csvInfer)), 0)
+	if err != nil {
+		t.Fatalf("Infer() is \"%+v\", want nil.", err)
+	}
+	rc := csvdata.NewRows(csvdata.New(strings.NewReader(csvInfer)), true).WithSchema(p.Schema())
+	for {
+		if err := rc.Next(); err != nil {
+			if !errors.Is(err, io.EOF) {
+				t.Errorf("Next() is \"%+v\", want \"%+v\".", err, io.EOF)
+			}
+			break
+		}
+	}
+}
+
+func TestInferMaxCardinality(t *testing.T) {
+	defer func(n int) { csvdata.InferMaxCardinality = n }(csvdata.InferMaxCardinality)
+	csvdata.InferMaxCardinality = 2
+	p, err := csvdata.Infer(csvdata.New(strings.NewReader(csvInfer)), 0)
+	if err != nil {
+		t.Fatalf("Infer() is \"%+v\", want nil.", err)
+	}
+	cards := []int{2, 2, 2, 2, 2, 1}
+	for i, c := range p.Columns {
+		if c.Cardinality != cards[i] {
+			t.Errorf("Infer().Columns[%d].Cardinality is %v, want %v.", i, c.Cardinality, cards[i])
+		}
+	}
+	if c := p.Columns[0]; c.Type != csvdata.TypeInt {
+		t.Errorf("Infer().Columns[0].Type is %v, want %v.", c.Type, csvdata.TypeInt)
+	}
+}
+
+func TestInferNil(t *testing.T) {
+	if _, err := csvdata.Infer(nil, 0); !errors.Is(err, csvdata.ErrNullPointer) {
+		t.Errorf("Infer() is \"%+v\", want \"%+v\".", err, csvdata.ErrNullPointer)
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
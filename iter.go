@@ -0,0 +1,83 @@
+package csvdata
+
+import (
+	"io"
+	"iter"
+
+	"github.com/goark/errs"
+)
+
+// All method returns iterator over rows with index (0-based) of them.
+// Iteration stops at the end of data or at the first error; the error is reported by Err method.
+//
+//	for _, row := range rc.All() {
+//		fmt.Println(row.Column("name"))
+//	}
+//	if err := rc.Err(); err != nil {
+//		return err
+//	}
+func (r *Rows) All() iter.Seq2[int, *Rows] {
+	return func(yield func(int, *Rows) bool) {
+		if r == nil {
+			return
+		}
+		r.err = nil
+		for i := 0; ; i++ {
+			if err := r.Next(); err != nil {
+				if !errs.Is(err, io.EOF) {
+					r.err = err
+				}
+				return
+			}
+			if !yield(i, r) {
+				return
+			}
+		}
+	}
+}
+
+// Records method returns iterator over records in rows.
+// Error except io.EOF is yielded with nil record, and iteration stops after that.
+// Yielded record is valid until the next iteration.
+func (r *Rows) Records() iter.Seq2[[]string, error] {
+	return func(yield func([]string, error) bool) {
+		if r == nil {
+			yield(nil, errs.Wrap(ErrNullPointer))
+			return
+		}
+		for {
+			if err := r.Next(); err != nil {
+				if !errs.Is(err, io.EOF) {
+					yield(nil, err)
+				}
+				return
+			}
+			if !yield(r.Row(), nil) {
+				return
+			}
+		}
+	}
+}
+
+// Err method returns error occurred in iteration by All method. It returns nil if iteration reached the end of data.
+func (r *Rows) Err() error {
+	if r == nil {
+		return errs.Wrap(ErrNullPointer)
+	}
+	return r.err
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
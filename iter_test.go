@@ -0,0 +1,97 @@
+package csvdata_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/goark/csvdata"
+)
+
+const csvIter = `name,mass
+Mercury,0.055
+Venus,0.815
+"Earth,1
+`
+
+func TestAll(t *testing.T) {
+	rc := csvdata.NewRows(csvdata.New(strings.NewReader(csvIter)), true)
+	defer rc.Close() //dummy
+	names := []string{}
+	for i, row := range rc.All() {
+		if i != len(names) {
+			t.Errorf("index is %v, want %v.", i, len(names))
+		}
+		names = append(names, row.Column("name"))
+	}
+	if strings.Join(names, ",") != "Mercury,Venus" {
+		t.Errorf("names are %v, want [Mercury Venus].", names)
+	}
+	if err := rc.Err(); !errors.Is(err, csvdata.ErrInvalidRecord) {
+		t.Errorf("Err() is \"%+v\", want \"%+v\".", err, csvdata.ErrInvalidRecord)
+	}
+}
+
+func TestAllBreak(t *testing.T) {
+	rc := csvdata.NewRows(csvdata.New(strings.NewReader(csvIter)), true)
+	defer rc.Close() //dummy
+	for range rc.All() {
+		break
+	}
+	if err := rc.Err(); err != nil {
+		t.Errorf("Err() is \"%+v\", want nil.", err)
+	}
+	for _, row := range rc.All() {
+		if s := row.Column("name"); s != "Venus" {
+			t.Errorf("Column() is %q, want %q.", s, "Venus")
+		}
+		break
+	}
+}
+
+func TestRecords(t *testing.T) {
+	rc := csvdata.NewRows(csvdata.New(strings.NewReader(csvIter)), true)
+	defer rc.Close() //dummy
+	names := []string{}
+	var err error
+	for record, e := range rc.Records() {
+		if e != nil {
+			err = e
+			break
+		}
+		names = append(names, record[0])
+	}
+	if strings.Join(names, ",") != "Mercury,Venus" {
+		t.Errorf("names are %v, want [Mercury Venus].", names)
+	}
+	if !errors.Is(err, csvdata.ErrInvalidRecord) {
+		t.Errorf("Records() yields \"%+v\", want \"%+v\".", err, csvdata.ErrInvalidRecord)
+	}
+}
+
+func TestRecordsNil(t *testing.T) {
+	var rc *csvdata.Rows
+	for _, err := range rc.Records() {
+		if !errors.Is(err, csvdata.ErrNullPointer) {
+			t.Errorf("Records() yields \"%+v\", want \"%+v\".", err, csvdata.ErrNullPointer)
+		}
+	}
+	if err := rc.Err(); !errors.Is(err, csvdata.ErrNullPointer) {
+		t.Errorf("Err() is \"%+v\", want \"%+v\".", err, csvdata.ErrNullPointer)
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
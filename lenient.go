@@ -0,0 +1,118 @@
+package csvdata
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/goark/errs"
+)
+
+// RawReader is optional interface for RowsReader to report raw text of the last read record.
+type RawReader interface {
+	Raw() string
+}
+
+// RecordError is information of invalid record skipped in lenient mode.
+type RecordError struct {
+	Line int    // line number in source data (0 if unknown)
+	Row  int    // index of data row (1-based)
+	Raw  string // raw text of record (empty if unknown)
+	Err  error
+}
+
+// Error method returns error message with position of record.
+// This method is a implementation of error interface.
+func (e *RecordError) Error() string {
+	if e == nil {
+		return "<nil>"
+	}
+	msg := "row " + strconv.Itoa(e.Row)
+	if e.Line > 0 {
+		msg = "line " + strconv.Itoa(e.Line) + ", " + msg
+	}
+	msg += ": " + e.Err.Error()
+	if len(e.Raw) > 0 {
+		msg += ": " + strconv.Quote(e.Raw)
+	}
+	return msg
+}
+
+// Unwrap method returns cause error in RecordError instance.
+// This method is used in errors.Unwrap function.
+func (e *RecordError) Unwrap() error {
+	if e == nil {
+		return nil
+	}
+	return e.Err
+}
+
+// ErrorReport is list of invalid records skipped in lenient mode.
+type ErrorReport []*RecordError
+
+// Error method returns error messages of all records, one per line.
+// This method is a implementation of error interface.
+func (rep ErrorReport) Error() string {
+	msgs := make([]string, 0, len(rep))
+	for _, e := range rep {
+		msgs = append(msgs, e.Error())
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// WithLenient method sets lenient mode. In lenient mode, Next method skips invalid records (ErrInvalidRecord or ErrSchemaViolation)
+// and accumulates them into ErrorReport. If count of invalid records exceeds maxErrors, Next method returns ErrTooManyErrors.
+// If maxErrors is negative, count of invalid records is unlimited.
+func (r *Rows) WithLenient(maxErrors int) *Rows {
+	if r == nil {
+		return nil
+	}
+	r.lenient = true
+	r.maxErrors = maxErrors
+	if rr, ok := r.reader.(*Reader); ok {
+		rr.WithRaw(true) // keep raw text for ErrorReport
+	}
+	return r
+}
+
+// Errors method returns invalid records skipped in lenient mode.
+func (r *Rows) Errors() ErrorReport {
+	if r == nil {
+		return nil
+	}
+	return r.report
+}
+
+// skip method records invalid record in lenient mode. It returns false if the record can not be skipped.
+func (r *Rows) skip(err error) (bool, error) {
+	if !r.lenient || (!errs.Is(err, ErrInvalidRecord) && !errs.Is(err, ErrSchemaViolation)) {
+		return false, nil
+	}
+	rerr := &RecordError{Row: r.rowIndex, Err: err}
+	var fe *FieldError
+	if errs.As(err, &fe) {
+		rerr.Line = fe.Line
+	}
+	if rr, ok := r.reader.(RawReader); ok {
+		rerr.Raw = rr.Raw()
+	}
+	r.report = append(r.report, rerr)
+	if r.maxErrors >= 0 && len(r.report) > r.maxErrors {
+		return false, errs.Wrap(ErrTooManyErrors, errs.WithCause(r.report), errs.WithContext("count", len(r.report)))
+	}
+	return true, nil
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
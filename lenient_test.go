@@ -0,0 +1,110 @@
+package csvdata_test
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/goark/csvdata"
+)
+
+const csvInvalid = `order,name
+1,Mercury
+2,Venus,extra
+3,"Earth"x
+4,Mars
+`
+
+func TestLenient(t *testing.T) {
+	rc := csvdata.NewRows(csvdata.New(strings.NewReader(csvInvalid)).WithLazyQuotes(false), true).WithLenient(-1)
+	defer rc.Close() //dummy
+	names := []string{}
+	for {
+		if err := rc.Next(); err != nil {
+			if !errors.Is(err, io.EOF) {
+				t.Errorf("Next() is \"%+v\", want \"%+v\".", err, io.EOF)
+			}
+			break
+		}
+		names = append(names, rc.Column("name"))
+	}
+	if strings.Join(names, ",") != "Mercury,Mars" {
+		t.Errorf("names are %v, want [Mercury Mars].", names)
+	}
+	report := rc.Errors()
+	if len(report) != 2 {
+		t.Fatalf("count of Errors() is %v, want 2.", len(report))
+	}
+	want := []csvdata.RecordError{{Line: 3, Row: 2, Raw: "2,Venus,extra"}, {Line: 4, Row: 3, Raw: `3,"Earth"x`}}
+	for i, e := range report {
+		if e.Line != want[i].Line || e.Row != want[i].Row || e.Raw != want[i].Raw || !errors.Is(e, csvdata.ErrInvalidRecord) {
+			t.Errorf("Errors()[%d] is %+v, want %+v.", i, e, want[i])
+		}
+	}
+	if msg := report.Error(); !strings.HasPrefix(msg, "line 3, row 2: ") || strings.Count(msg, "\n") != 1 {
+		t.Errorf("ErrorReport.Error() is %q.", msg)
+	}
+}
+
+func TestLenientBudget(t *testing.T) {
+	rc := csvdata.NewRows(csvdata.New(strings.NewReader(csvInvalid)).WithLazyQuotes(false), true).WithLenient(1)
+	defer rc.Close() //dummy
+	var err error
+	for err == nil {
+		err = rc.Next()
+	}
+	if !errors.Is(err, csvdata.ErrTooManyErrors) {
+		t.Errorf("Next() is \"%+v\", want \"%+v\".", err, csvdata.ErrTooManyErrors)
+	}
+	if len(rc.Errors()) != 2 {
+		t.Errorf("count of Errors() is %v, want 2.", len(rc.Errors()))
+	}
+}
+
+func TestRaw(t *testing.T) {
+	testCases := []struct {
+		raw  bool
+		want []string
+	}{
+		{raw: false, want: []string{"", "", "", "", ""}},
+		{raw: true, want: []string{"order,name", "1,Mercury", "2,Venus,extra", `3,"Earth"x`, "4,Mars"}},
+	}
+	for _, tc := range testCases {
+		r := csvdata.New(strings.NewReader(csvInvalid)).WithLazyQuotes(false).WithRaw(tc.raw)
+		for _, w := range tc.want {
+			if _, err := r.Read(); err != nil && !errors.Is(err, csvdata.ErrInvalidRecord) {
+				t.Fatalf("Read() is \"%+v\", want nil.", err)
+			}
+			if raw := r.Raw(); raw != w {
+				t.Errorf("Raw() is %q, want %q.", raw, w)
+			}
+		}
+	}
+}
+
+func TestStrict(t *testing.T) {
+	rc := csvdata.NewRows(csvdata.New(strings.NewReader(csvInvalid)), true)
+	defer rc.Close() //dummy
+	if err := rc.Next(); err != nil {
+		t.Fatalf("Next() is \"%+v\", want nil.", err)
+	}
+	if err := rc.Next(); !errors.Is(err, csvdata.ErrInvalidRecord) {
+		t.Errorf("Next() is \"%+v\", want \"%+v\".", err, csvdata.ErrInvalidRecord)
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,159 @@
+package csvdata
+
+import (
+	"slices"
+	"strings"
+	"sync"
+	"unicode"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+)
+
+// HeaderNormalizer is function type for normalizing header names and column names in lookup.
+type HeaderNormalizer func(string) string
+
+// folders is pool of cases.Caser for case folding (cases.Caser is not safe for concurrent use).
+var folders = sync.Pool{New: func() any { c := cases.Fold(); return &c }}
+
+// FoldCase function is HeaderNormalizer for case folding. (e.g. "NAME" to "name")
+func FoldCase(s string) string {
+	c := folders.Get().(*cases.Caser)
+	defer folders.Put(c)
+	return c.String(s)
+}
+
+// FoldWidth function is HeaderNormalizer for Unicode NFKC normalization. (e.g. full-width "ＮＡＭＥ" to "NAME")
+func FoldWidth(s string) string {
+	return norm.NFKC.String(s)
+}
+
+// CollapseSpace function is HeaderNormalizer for trimming and collapsing white spaces (including full-width space) into a single space.
+func CollapseSpace(s string) string {
+	return strings.Join(strings.FieldsFunc(s, unicode.IsSpace), " ")
+}
+
+// StripPunct function is HeaderNormalizer for removing punctuation characters. (e.g. "e-mail" to "email")
+func StripPunct(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsPunct(r) {
+			return -1
+		}
+		return r
+	}, s)
+}
+
+// ChainNormalizers function returns HeaderNormalizer that applies fs in order.
+func ChainNormalizers(fs ...HeaderNormalizer) HeaderNormalizer {
+	return func(s string) string {
+		for _, f := range fs {
+			if f != nil {
+				s = f(s)
+			}
+		}
+		return s
+	}
+}
+
+// DefaultNormalizer is HeaderNormalizer applying FoldWidth, FoldCase, StripPunct and CollapseSpace.
+var DefaultNormalizer = ChainNormalizers(FoldWidth, FoldCase, StripPunct, CollapseSpace)
+
+// WithHeaderNormalizer method sets HeaderNormalizer for header names and column names in lookup.
+// It must be set before reading header. Header names are always trimmed spaces.
+func (r *Rows) WithHeaderNormalizer(f HeaderNormalizer) *Rows {
+	if r == nil {
+		return nil
+	}
+	r.normalizer = f
+	r.aliasKeys = make([][]string, 0, len(r.aliases))
+	for _, group := range r.aliases {
+		r.aliasKeys = append(r.aliasKeys, r.normalizeAll(group))
+	}
+	r.resetLookup()
+	return r
+}
+
+// WithColumnAlias method sets aliases of column name. Lookup by one of name and aliases finds the column whose header matches any of them.
+func (r *Rows) WithColumnAlias(name string, aliases ...string) *Rows {
+	if r == nil {
+		return nil
+	}
+	group := append([]string{name}, aliases...)
+	r.aliases = append(r.aliases, group)
+	r.aliasKeys = append(r.aliasKeys, r.normalizeAll(group))
+	r.resetLookup()
+	return r
+}
+
+// normalize method returns key of header map.
+func (r *Rows) normalize(s string) string {
+	s = strings.TrimSpace(s)
+	if r.normalizer != nil {
+		s = r.normalizer(s)
+	}
+	return s
+}
+
+// normalizeAll method returns keys of header map.
+func (r *Rows) normalizeAll(names []string) []string {
+	keys := make([]string, len(names))
+	for i, name := range names {
+		keys[i] = r.normalize(name)
+	}
+	return keys
+}
+
+// columnKeys method returns normalized name and aliases of column.
+func (r *Rows) columnKeys(s string) []string {
+	key := r.normalize(s)
+	for _, group := range r.aliasKeys {
+		if slices.Contains(group, key) {
+			return append([]string{key}, group...)
+		}
+	}
+	return []string{key}
+}
+
+// buildLookup method makes map of normalized names and aliases to indexes in header (-1 if not found).
+// Alias group registered first takes precedence over later groups.
+func (r *Rows) buildLookup() {
+	r.lookup = make(map[string]int, len(r.headerMap))
+	for key, i := range r.headerMap {
+		r.lookup[key] = i
+	}
+	for _, group := range r.aliasKeys {
+		i := -1
+		for _, key := range group {
+			if j, ok := r.headerMap[key]; ok {
+				i = j
+				break
+			}
+		}
+		for _, key := range group {
+			if _, ok := r.lookup[key]; !ok {
+				r.lookup[key] = i
+			}
+		}
+	}
+	r.columns = map[string]int{}
+}
+
+// resetLookup method clears map of lookup and cache of column names.
+func (r *Rows) resetLookup() {
+	r.lookup, r.columns = nil, nil
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
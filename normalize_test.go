@@ -0,0 +1,89 @@
+package csvdata_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/goark/csvdata"
+)
+
+func TestHeaderNormalizer(t *testing.T) {
+	testCases := []struct {
+		f    csvdata.HeaderNormalizer
+		s    string
+		want string
+	}{
+		{f: csvdata.FoldCase, s: "NAME", want: "name"},
+		{f: csvdata.FoldWidth, s: "ＮＡＭＥ１", want: "NAME1"},
+		{f: csvdata.CollapseSpace, s: " full \t　name ", want: "full name"},
+		{f: csvdata.StripPunct, s: "e-mail (home)", want: "email home"},
+		{f: csvdata.DefaultNormalizer, s: "Ｅ-Mail　 Address", want: "email address"},
+		{f: csvdata.ChainNormalizers(), s: "Name", want: "Name"},
+	}
+	for _, tc := range testCases {
+		if s := tc.f(tc.s); s != tc.want {
+			t.Errorf("normalizer(%q) is %q, want %q.", tc.s, s, tc.want)
+		}
+	}
+}
+
+func TestColumnLookup(t *testing.T) {
+	testCases := []struct {
+		input string
+		f     csvdata.HeaderNormalizer
+		name  string
+		want  string
+	}{
+		{input: "NAME ,mass\nEarth,1\n", f: nil, name: "NAME", want: "Earth"},
+		{input: "NAME ,mass\nEarth,1\n", f: nil, name: "name", want: ""},
+		{input: "NAME ,mass\nEarth,1\n", f: csvdata.DefaultNormalizer, name: "name", want: "Earth"},
+		{input: "名前　,mass\nEarth,1\n", f: csvdata.DefaultNormalizer, name: "名前", want: "Earth"},
+		{input: "Planet Name,mass\nEarth,1\n", f: csvdata.DefaultNormalizer, name: "name", want: "Earth"},
+		{input: "planet_name,mass\nEarth,1\n", f: csvdata.DefaultNormalizer, name: "name", want: "Earth"},
+		{input: "名前,mass\nEarth,1\n", f: csvdata.DefaultNormalizer, name: "Planet-Name", want: "Earth"},
+	}
+	for _, tc := range testCases {
+		rc := csvdata.NewRows(csvdata.New(strings.NewReader(tc.input)), true).WithHeaderNormalizer(tc.f).WithColumnAlias("name", "planet name", "planetname", "名前")
+		if err := rc.Next(); err != nil {
+			t.Errorf("Next() is \"%+v\", want nil.", err)
+			continue
+		}
+		if s := rc.Column(tc.name); s != tc.want {
+			t.Errorf("Column(%q) in %q is %q, want %q.", tc.name, tc.input, s, tc.want)
+		}
+	}
+}
+
+func TestColumnLookupOrder(t *testing.T) {
+	rc := csvdata.NewRows(csvdata.New(strings.NewReader("E-Mail,Planet Name\nearth@example.com,Earth\n")), true).WithColumnAlias("name", "planet name").WithHeaderNormalizer(csvdata.DefaultNormalizer)
+	if err := rc.Next(); err != nil {
+		t.Fatalf("Next() is \"%+v\", want nil.", err)
+	}
+	for range 2 { // lookup is cached
+		if s := rc.Column("NAME"); s != "Earth" {
+			t.Errorf("Column(%q) is %q, want %q.", "NAME", s, "Earth")
+		}
+		if s := rc.Column("mail"); s != "" {
+			t.Errorf("Column(%q) is %q, want %q.", "mail", s, "")
+		}
+	}
+	rc.WithColumnAlias("mail", "email")
+	if s := rc.Column("mail"); s != "earth@example.com" {
+		t.Errorf("Column(%q) is %q, want %q.", "mail", s, "earth@example.com")
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,525 @@
+package csvdata
+
+import (
+	"bytes"
+	"encoding/csv"
+	"io"
+	"runtime"
+	"slices"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/goark/errs"
+)
+
+// DefaultChunkSize is default size of chunk in ParallelReader.
+const DefaultChunkSize = 4 << 20
+
+// ParallelReader is class of CSV reader that splits seekable data into chunks on record boundaries and parses them concurrently.
+// Input data must be UTF-8 text (BOM at the beginning of input is removed).
+type ParallelReader struct {
+	source           io.ReaderAt
+	size             int64
+	comma            rune
+	trimSpace        bool
+	lazyQuotes       bool
+	trimLeadingSpace bool
+	fieldsPerRecord  int
+	workers          int
+	chunkSize        int
+	ordered          bool
+	closer           func() error
+
+	started  bool
+	closed   bool
+	first    bool // first chunk is not received yet
+	jobs     chan *parallelChunk
+	queue    chan *parallelChunk // chunks in order of source data
+	finished chan *parallelChunk // chunks in order of completion (unordered mode)
+	quit     chan struct{}
+	wg       sync.WaitGroup
+	chunk    *parallelChunk
+	next     int // index of next record in chunk
+	record   *parallelRecord
+	err      error
+}
+
+var (
+	_ RowsReader     = (*ParallelReader)(nil) //ParallelReader is compatible with RowsReader interface
+	_ PositionReader = (*ParallelReader)(nil) //ParallelReader is compatible with PositionReader interface
+	_ RawReader      = (*ParallelReader)(nil) //ParallelReader is compatible with RawReader interface
+)
+
+// parallelChunk is a part of source data which starts and ends at record boundaries.
+type parallelChunk struct {
+	index   int
+	line    int // line number of the first line in chunk
+	data    []byte
+	records []parallelRecord
+	pos     []int // line and column of each field in records
+	err     error
+	done    chan struct{}
+}
+
+// parallelRecord is a parsed record in chunk.
+type parallelRecord struct {
+	fields     []string
+	pos        int // offset of positions in parallelChunk.pos
+	start, end int // range of raw text in parallelChunk.data
+	err        error
+}
+
+// NewParallel function creates a new ParallelReader instance for size bytes of r.
+// Records are delivered in order of source data by default (see WithOrdered method).
+func NewParallel(r io.ReaderAt, size int64) *ParallelReader {
+	closer := func() error { return nil }
+	if c, ok := r.(io.Closer); ok {
+		closer = c.Close
+	}
+	return &ParallelReader{
+		source:           r,
+		size:             size,
+		comma:            ',',
+		lazyQuotes:       true,
+		trimLeadingSpace: true,
+		workers:          runtime.GOMAXPROCS(0),
+		chunkSize:        DefaultChunkSize,
+		ordered:          true,
+		closer:           closer,
+	}
+}
+
+// TrimSpace returns TrimSpace option.
+func (r *ParallelReader) TrimSpace() bool {
+	return r.trimSpace
+}
+
+// LazyQuotes returns LazyQuotes option.
+func (r *ParallelReader) LazyQuotes() bool {
+	return r.lazyQuotes
+}
+
+// WithComma method sets Comma property.
+func (r *ParallelReader) WithComma(c rune) *ParallelReader {
+	if r == nil {
+		return nil
+	}
+	r.comma = c
+	return r
+}
+
+// WithTrimSpace method sets trimSpace and TrimLeadingSpace property.
+func (r *ParallelReader) WithTrimSpace(mode bool) *ParallelReader {
+	if r == nil {
+		return nil
+	}
+	r.trimSpace = mode
+	r.trimLeadingSpace = mode
+	return r
+}
+
+// WithLazyQuotes method sets LazyQuotes property.
+func (r *ParallelReader) WithLazyQuotes(mode bool) *ParallelReader {
+	if r == nil {
+		return nil
+	}
+	r.lazyQuotes = mode
+	return r
+}
+
+// WithTrimLeadingSpace method sets TrimLeadingSpace property.
+func (r *ParallelReader) WithTrimLeadingSpace(mode bool) *ParallelReader {
+	if r == nil {
+		return nil
+	}
+	r.trimLeadingSpace = mode
+	return r
+}
+
+// WithFieldsPerRecord method sets FieldsPerRecord property.
+func (r *ParallelReader) WithFieldsPerRecord(size int) *ParallelReader {
+	if r == nil {
+		return nil
+	}
+	r.fieldsPerRecord = size
+	return r
+}
+
+// WithWorkers method sets number of goroutines parsing chunks (default runtime.GOMAXPROCS(0)).
+func (r *ParallelReader) WithWorkers(n int) *ParallelReader {
+	if r == nil {
+		return nil
+	}
+	if n > 0 {
+		r.workers = n
+	}
+	return r
+}
+
+// WithChunkSize method sets approximate size of chunk in bytes (default DefaultChunkSize).
+// A chunk is extended to the end of record, so a record larger than size is not split.
+func (r *ParallelReader) WithChunkSize(size int) *ParallelReader {
+	if r == nil {
+		return nil
+	}
+	if size > 0 {
+		r.chunkSize = size
+	}
+	return r
+}
+
+// WithOrdered method sets ordered mode (default true). If mode is false, records are delivered in order of completion of chunks,
+// but the first record (header) is always delivered first and records in a chunk keep their order.
+func (r *ParallelReader) WithOrdered(mode bool) *ParallelReader {
+	if r == nil {
+		return nil
+	}
+	r.ordered = mode
+	return r
+}
+
+// Read method returns next row data.
+func (r *ParallelReader) Read() ([]string, error) {
+	if r == nil {
+		return nil, errs.Wrap(ErrNullPointer)
+	}
+	if !r.started {
+		r.start()
+	}
+	for r.chunk == nil || r.next >= len(r.chunk.records) {
+		r.chunk, r.next, r.record = nil, 0, nil
+		c, err := r.nextChunk()
+		if err != nil {
+			return nil, err
+		}
+		r.chunk = c
+	}
+	r.record = &r.chunk.records[r.next]
+	r.next++
+	if r.record.err != nil {
+		return nil, r.record.err
+	}
+	if r.fieldsPerRecord == 0 {
+		r.fieldsPerRecord = len(r.record.fields)
+	} else if r.fieldsPerRecord > 0 && len(r.record.fields) != r.fieldsPerRecord {
+		pos := r.Position(0)
+		fe := &FieldError{Position: Position{Line: pos.Line, Column: 1}, Index: -1, Err: errs.Wrap(ErrInvalidRecord, errs.WithCause(csv.ErrFieldCount))}
+		return nil, errs.Wrap(fe, errs.WithContext("line", fe.Line), errs.WithContext("column", fe.Column))
+	}
+	return r.record.fields, nil
+}
+
+// Raw method returns raw text of the last read record (without empty lines around it).
+func (r *ParallelReader) Raw() string {
+	if r == nil || r.record == nil {
+		return ""
+	}
+	return strings.Trim(string(r.chunk.data[r.record.start:r.record.end]), "\r\n")
+}
+
+// Position method returns position of field in the last read record.
+func (r *ParallelReader) Position(field int) Position {
+	if r == nil || r.record == nil || len(r.record.fields) == 0 || field < 0 {
+		return Position{}
+	}
+	if field >= len(r.record.fields) {
+		return Position{Line: r.chunk.pos[r.record.pos+2*(len(r.record.fields)-1)]}
+	}
+	i := r.record.pos + 2*field
+	return Position{Line: r.chunk.pos[i], Column: r.chunk.pos[i+1]}
+}
+
+// Close method stops parsing goroutines and closes the underlying io.ReaderAt if it is io.Closer.
+func (r *ParallelReader) Close() error {
+	if r == nil {
+		return nil
+	}
+	if r.started && !r.closed {
+		r.closed = true
+		close(r.quit)
+		r.wg.Wait()
+	}
+	if r.closer == nil {
+		return nil
+	}
+	return r.closer()
+}
+
+// start method starts goroutines splitting and parsing source data.
+func (r *ParallelReader) start() {
+	r.started, r.first = true, true
+	r.quit = make(chan struct{})
+	r.jobs = make(chan *parallelChunk, r.workers)
+	r.queue = make(chan *parallelChunk, 2*r.workers)
+	r.finished = make(chan *parallelChunk, r.workers)
+	if !validDelimiter(r.comma) {
+		r.err = errs.Wrap(ErrInvalidDelimiter, errs.WithContext("comma", string(r.comma)))
+		close(r.jobs)
+		close(r.queue)
+		close(r.finished)
+		return
+	}
+	r.wg.Add(r.workers + 1)
+	go r.split()
+	for i := 0; i < r.workers; i++ {
+		go r.work()
+	}
+	go func() {
+		r.wg.Wait()
+		close(r.finished)
+	}()
+}
+
+// nextChunk method returns next parsed chunk.
+func (r *ParallelReader) nextChunk() (*parallelChunk, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	var c *parallelChunk
+	var ok bool
+	if r.ordered || r.first {
+		c, ok = <-r.queue
+		r.first = false
+	} else {
+		c, ok = <-r.finished
+	}
+	if !ok {
+		r.err = errs.Wrap(io.EOF)
+		return nil, r.err
+	}
+	<-c.done
+	if c.err != nil {
+		r.err = c.err
+		return nil, r.err
+	}
+	return c, nil
+}
+
+// split method reads source data and passes chunks to workers.
+func (r *ParallelReader) split() {
+	defer r.wg.Done()
+	defer close(r.queue)
+	defer close(r.jobs)
+	scan := newBoundaryScanner(r.comma, r.lazyQuotes, r.trimLeadingSpace)
+	buf := make([]byte, 0, r.chunkSize)
+	offset, index, line := int64(0), 0, 1
+	head := make([]byte, len(bom))
+	if n, _ := r.source.ReadAt(head, 0); r.size >= int64(len(head)) && n == len(head) && string(head) == bom {
+		offset = int64(len(bom))
+	}
+	for {
+		eof := offset >= r.size
+		if !eof {
+			n := int64(r.chunkSize)
+			if rest := r.size - offset; rest < n {
+				n = rest
+			}
+			size := len(buf)
+			buf = slices.Grow(buf, int(n))[:size+int(n)]
+			m, err := r.source.ReadAt(buf[size:], offset)
+			buf = buf[:size+m]
+			offset += int64(m)
+			if err != nil {
+				if !errs.Is(err, io.EOF) {
+					c := &parallelChunk{index: index, err: errs.Wrap(err, errs.WithContext("offset", offset)), done: make(chan struct{})}
+					close(c.done)
+					r.deliver(c)
+					return
+				}
+				offset = r.size
+			}
+			eof = offset >= r.size
+		}
+		scan.scan(buf)
+		end := scan.last
+		if eof {
+			end = len(buf)
+		} else if len(buf) < r.chunkSize || end == 0 {
+			continue
+		}
+		if end == 0 {
+			return
+		}
+		c := &parallelChunk{index: index, line: line, data: buf[:end], done: make(chan struct{})}
+		if !r.send(r.jobs, c) || ((r.ordered || index == 0) && !r.send(r.queue, c)) {
+			return
+		}
+		if eof {
+			return
+		}
+		index++
+		line += bytes.Count(c.data, []byte{'\n'})
+		rest := buf[end:]
+		buf = append(make([]byte, 0, r.chunkSize+len(rest)), rest...)
+		scan.shift(end)
+	}
+}
+
+// work method parses chunks passed by split method.
+func (r *ParallelReader) work() {
+	defer r.wg.Done()
+	for c := range r.jobs {
+		r.parse(c)
+		close(c.done)
+		if !r.ordered && c.index > 0 && !r.send(r.finished, c) {
+			return
+		}
+	}
+}
+
+// parse method parses records in chunk.
+func (r *ParallelReader) parse(c *parallelChunk) {
+	cr := csv.NewReader(bytes.NewReader(c.data))
+	cr.Comma = r.comma
+	cr.LazyQuotes = r.lazyQuotes
+	cr.TrimLeadingSpace = r.trimLeadingSpace
+	cr.FieldsPerRecord = -1 // checked in Read method across chunks
+	for {
+		start := cr.InputOffset()
+		elms, err := cr.Read()
+		if errs.Is(err, io.EOF) {
+			return
+		}
+		rec := parallelRecord{pos: len(c.pos), start: int(start), end: int(cr.InputOffset())}
+		if err != nil {
+			fe := &FieldError{Index: -1, Err: errs.Wrap(ErrInvalidRecord, errs.WithCause(err))}
+			var perr *csv.ParseError
+			if errs.As(err, &perr) {
+				fe.Line, fe.Column = perr.Line+c.line-1, perr.Column
+			}
+			rec.err = errs.Wrap(fe, errs.WithContext("line", fe.Line), errs.WithContext("column", fe.Column))
+		} else {
+			rec.fields = elms
+			for i := range elms {
+				line, column := cr.FieldPos(i)
+				c.pos = append(c.pos, line+c.line-1, column)
+			}
+		}
+		c.records = append(c.records, rec)
+	}
+}
+
+// deliver method passes chunk with error to reader.
+func (r *ParallelReader) deliver(c *parallelChunk) {
+	if r.ordered || c.index == 0 {
+		r.send(r.queue, c)
+		return
+	}
+	r.send(r.finished, c)
+}
+
+// send method sends chunk to ch. It returns false if the reader is closed.
+func (r *ParallelReader) send(ch chan<- *parallelChunk, c *parallelChunk) bool {
+	select {
+	case ch <- c:
+		return true
+	case <-r.quit:
+		return false
+	}
+}
+
+// boundaryScanner is quote-aware scanner of record boundaries in CSV data.
+// It follows the rules of encoding/csv package, so that each chunk is parsed as same as whole data.
+type boundaryScanner struct {
+	comma       []byte
+	lazyQuotes  bool
+	trimLeading bool
+	pos         int  // scanned position in buffer
+	last        int  // position just after the last line feed out of quoted field (0 if not found)
+	fieldStart  bool // at the beginning of field
+	quoted      bool // in quoted field
+}
+
+func newBoundaryScanner(comma rune, lazyQuotes, trimLeading bool) *boundaryScanner {
+	return &boundaryScanner{comma: []byte(string(comma)), lazyQuotes: lazyQuotes, trimLeading: trimLeading, fieldStart: true}
+}
+
+// shift method discards scanned data before n bytes.
+func (s *boundaryScanner) shift(n int) {
+	s.pos -= n
+	s.last = 0
+}
+
+// scan method scans buf from the last scanned position. It stops before data whose meaning depends on following bytes.
+func (s *boundaryScanner) scan(buf []byte) {
+	for s.pos < len(buf) {
+		rest := buf[s.pos:]
+		if s.quoted {
+			i := bytes.IndexByte(rest, '"')
+			if i < 0 {
+				s.pos = len(buf)
+				return
+			}
+			rest = rest[i+1:]
+			if len(rest) == 0 || (rest[0] == '\r' && len(rest) == 1) || (rest[0] == s.comma[0] && len(rest) < len(s.comma)) {
+				s.pos += i
+				return
+			}
+			s.pos += i + 1
+			switch {
+			case rest[0] == '"': // escaped quote
+				s.pos++
+			case bytes.HasPrefix(rest, s.comma), rest[0] == '\n', bytes.HasPrefix(rest, []byte("\r\n")):
+				s.quoted = false
+			case !s.lazyQuotes: // error of quote; rest of line is discarded
+				s.quoted = false
+			}
+			continue
+		}
+		c := rest[0]
+		if c == '\n' {
+			s.pos++
+			s.last, s.fieldStart = s.pos, true
+			continue
+		}
+		if c == s.comma[0] {
+			if len(rest) < len(s.comma) && bytes.HasPrefix(s.comma, rest) {
+				return
+			}
+			if bytes.HasPrefix(rest, s.comma) {
+				s.pos += len(s.comma)
+				s.fieldStart = true
+				continue
+			}
+		}
+		if s.fieldStart {
+			if c == '"' {
+				s.pos++
+				s.quoted, s.fieldStart = true, false
+				continue
+			}
+			if s.trimLeading {
+				if !utf8.FullRune(rest) {
+					return
+				}
+				if rn, size := utf8.DecodeRune(rest); unicode.IsSpace(rn) {
+					s.pos += size
+					continue
+				}
+			}
+		}
+		// skip the rest of unquoted field
+		s.pos++
+		s.fieldStart = false
+		for s.pos < len(buf) && buf[s.pos] != '\n' && buf[s.pos] != s.comma[0] {
+			s.pos++
+		}
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,196 @@
+package csvdata_test
+
+import (
+	"errors"
+	"io"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/goark/csvdata"
+)
+
+type parallelResult struct {
+	fields string
+	line   int
+	column int
+	raw    string
+	err    bool
+}
+
+func readResults(t *testing.T, rr interface {
+	csvdata.RowsReader
+	csvdata.PositionReader
+	csvdata.RawReader
+}) []parallelResult {
+	t.Helper()
+	results := []parallelResult{}
+	for {
+		elms, err := rr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		res := parallelResult{fields: strings.Join(elms, "|"), raw: strings.TrimLeft(rr.Raw(), "\r\n"), err: err != nil}
+		if err != nil {
+			if !errors.Is(err, csvdata.ErrInvalidRecord) {
+				t.Fatalf("Read() is \"%+v\", want \"%+v\".", err, csvdata.ErrInvalidRecord)
+			}
+			var fe *csvdata.FieldError
+			if errors.As(err, &fe) {
+				res.line, res.column = fe.Line, fe.Column
+			}
+		} else {
+			pos := rr.Position(len(elms) - 1)
+			res.line, res.column = pos.Line, pos.Column
+		}
+		results = append(results, res)
+	}
+	return results
+}
+
+func TestParallelReader(t *testing.T) {
+	testCases := []struct {
+		name  string
+		data  string
+		comma rune
+		lazy  bool
+		trim  bool
+	}{
+		{name: "simple", data: csv1, comma: ',', lazy: true, trim: true},
+		{name: "no trailing newline", data: "a,b\nc,d", comma: ',', lazy: true, trim: true},
+		{name: "bom", data: "\ufeffa,b\r\nc,d\r\n", comma: ',', lazy: true, trim: true},
+		{name: "quoted", data: "h1,h2\n\"a\nb\",\"c,\"\"d\"\"\r\ne\"\n\n\"\",\"\"\"\"\n \"x\ny\",z\n\"p\"\r\n", comma: ',', lazy: true, trim: true},
+		{name: "quoted without trimming", data: "h1,h2\n \"a\nb\",\"c\n\"\" d\"\n", comma: ',', lazy: true, trim: false},
+		{name: "lazy quotes", data: "h1,h2\na\"b,\"c\"d\ne\nf\",g\n\"h\"\"\",i\"\n", comma: ',', lazy: true, trim: true},
+		{name: "strict quotes", data: "h1,h2\na\"b,c\n\"d\"e,\"f\ng\"\n\"h\ni\"j\nk,l\n\"m\",n\n", comma: ',', lazy: false, trim: true},
+		{name: "tab", data: tsv1, comma: '\t', lazy: true, trim: true},
+		{name: "multi-byte comma", data: "a、\"b、\nc\"\n　\"d\"、e\n", comma: '、', lazy: true, trim: true},
+	}
+	for _, tc := range testCases {
+		want := readResults(t, csvdata.New(strings.NewReader(tc.data)).WithRaw(true).WithComma(tc.comma).WithLazyQuotes(tc.lazy).WithTrimLeadingSpace(tc.trim).WithFieldsPerRecord(-1))
+		for size := 1; size <= len(tc.data)+1; size++ {
+			for _, workers := range []int{1, 3} {
+				rr := csvdata.NewParallel(strings.NewReader(tc.data), int64(len(tc.data))).WithComma(tc.comma).WithLazyQuotes(tc.lazy).WithTrimLeadingSpace(tc.trim).WithFieldsPerRecord(-1).WithChunkSize(size).WithWorkers(workers)
+				got := readResults(t, rr)
+				if err := rr.Close(); err != nil {
+					t.Errorf("Close() is \"%+v\", want nil.", err)
+				}
+				if len(got) != len(want) {
+					t.Errorf("[%s: size %d] Read() returns %d records, want %d.", tc.name, size, len(got), len(want))
+					continue
+				}
+				for i := range want {
+					if got[i] != want[i] {
+						t.Errorf("[%s: size %d] record %d is \"%+v\", want \"%+v\".", tc.name, size, i, got[i], want[i])
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestParallelReaderUnordered(t *testing.T) {
+	data := "order,name\n"
+	want := []string{}
+	for i := 1; i <= 500; i++ {
+		s := strings.Repeat("x", i%7)
+		data += s + ",\"" + s + "\n\"\n"
+		want = append(want, s+"|"+s+"\n")
+	}
+	sort.Strings(want)
+	rr := csvdata.NewParallel(strings.NewReader(data), int64(len(data))).WithChunkSize(64).WithWorkers(4).WithOrdered(false)
+	defer rr.Close()
+	results := readResults(t, rr)
+	if len(results) == 0 || results[0].fields != "order|name" {
+		t.Fatalf("first record is \"%+v\", want header.", results)
+	}
+	got := []string{}
+	for _, res := range results[1:] {
+		got = append(got, res.fields)
+	}
+	sort.Strings(got)
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("records are %q, want %q.", got, want)
+	}
+}
+
+func TestParallelReaderFieldsPerRecord(t *testing.T) {
+	data := "a,b\nc,d\ne\nf,g\n"
+	rr := csvdata.NewParallel(strings.NewReader(data), int64(len(data))).WithChunkSize(4)
+	defer rr.Close()
+	results := readResults(t, rr)
+	want := []parallelResult{
+		{fields: "a|b", line: 1, column: 3, raw: "a,b"},
+		{fields: "c|d", line: 2, column: 3, raw: "c,d"},
+		{line: 3, column: 1, raw: "e", err: true},
+		{fields: "f|g", line: 4, column: 3, raw: "f,g"},
+	}
+	if len(results) != len(want) {
+		t.Fatalf("Read() returns %d records, want %d.", len(results), len(want))
+	}
+	for i := range want {
+		if results[i] != want[i] {
+			t.Errorf("record %d is \"%+v\", want \"%+v\".", i, results[i], want[i])
+		}
+	}
+}
+
+func TestParallelReaderInvalidComma(t *testing.T) {
+	rr := csvdata.NewParallel(strings.NewReader(csv1), int64(len(csv1))).WithComma('"')
+	defer rr.Close()
+	if _, err := rr.Read(); !errors.Is(err, csvdata.ErrInvalidDelimiter) {
+		t.Errorf("Read() is \"%+v\", want \"%+v\".", err, csvdata.ErrInvalidDelimiter)
+	}
+}
+
+func TestParallelReaderRows(t *testing.T) {
+	file, err := csvdata.OpenFile("testdata/sample.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		t.Fatal(err)
+	}
+	rc := csvdata.NewRows(csvdata.NewParallel(file, info.Size()).WithTrimSpace(true).WithChunkSize(16), true)
+	defer rc.Close()
+	names := []string{}
+	for {
+		if err := rc.Next(); err != nil {
+			if !errors.Is(err, io.EOF) {
+				t.Errorf("Next() is \"%+v\", want \"%+v\".", err, io.EOF)
+			}
+			break
+		}
+		names = append(names, rc.Column("name"))
+	}
+	if got, want := strings.Join(names, ","), "Mercury,Venus,Earth,Mars"; got != want {
+		t.Errorf("names are %q, want %q.", got, want)
+	}
+}
+
+func TestParallelReaderClose(t *testing.T) {
+	data := strings.Repeat("a,b,c\n", 10000)
+	rr := csvdata.NewParallel(strings.NewReader(data), int64(len(data))).WithChunkSize(32).WithWorkers(2)
+	if _, err := rr.Read(); err != nil {
+		t.Errorf("Read() is \"%+v\", want nil.", err)
+	}
+	if err := rr.Close(); err != nil {
+		t.Errorf("Close() is \"%+v\", want nil.", err)
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
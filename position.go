@@ -0,0 +1,94 @@
+package csvdata
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Position is position of a field in source data.
+type Position struct {
+	Line   int    // line number in CSV data, or row number in spreadsheet (1-based; 0 if unknown)
+	Column int    // column position in line of CSV data (1-based, in bytes), or column number in spreadsheet (1-based; 0 if unknown)
+	Cell   string // cell reference in spreadsheet (e.g. "C7"; empty for CSV data)
+}
+
+// PositionReader is optional interface for RowsReader to report position of a field in the last read record.
+type PositionReader interface {
+	Position(field int) Position
+}
+
+// FieldError is error with position of field.
+type FieldError struct {
+	Position
+	Row   int    // index of data row (1-based; 0 for header row)
+	Index int    // index of field in record (0-based; -1 if unknown)
+	Name  string // header name of field
+	Err   error
+}
+
+// Error method returns error message with position of field.
+// This method is a implementation of error interface.
+func (e *FieldError) Error() string {
+	if e == nil {
+		return "<nil>"
+	}
+	elms := []string{}
+	if e.Line > 0 {
+		elms = append(elms, "line "+strconv.Itoa(e.Line))
+		if e.Column > 0 {
+			elms = append(elms, "column "+strconv.Itoa(e.Column))
+		}
+	}
+	if len(e.Cell) > 0 {
+		elms = append(elms, "cell "+e.Cell)
+	}
+	if e.Row > 0 {
+		elms = append(elms, "row "+strconv.Itoa(e.Row))
+	}
+	if e.Index >= 0 {
+		elms = append(elms, "field "+strconv.Itoa(e.Index))
+	}
+	if len(e.Name) > 0 {
+		elms = append(elms, strconv.Quote(e.Name))
+	}
+	if len(elms) == 0 {
+		return e.Err.Error()
+	}
+	return strings.Join(elms, ", ") + ": " + e.Err.Error()
+}
+
+// Unwrap method returns cause error in FieldError instance.
+// This method is used in errors.Unwrap function.
+func (e *FieldError) Unwrap() error {
+	if e == nil {
+		return nil
+	}
+	return e.Err
+}
+
+// CellName function returns cell reference in A1 style by column and row numbers (1-based). (e.g. CellName(3, 7) returns "C7")
+func CellName(column, row int) string {
+	if column < 1 || row < 1 {
+		return ""
+	}
+	name := []byte{}
+	for ; column > 0; column = (column - 1) / 26 {
+		name = append([]byte{byte('A' + (column-1)%26)}, name...)
+	}
+	return string(name) + strconv.Itoa(row)
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,88 @@
+package csvdata_test
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/goark/csvdata"
+)
+
+func TestFieldError(t *testing.T) {
+	rc := csvdata.NewRows(csvdata.New(strings.NewReader(csv1)).WithTrimSpace(true), true)
+	defer rc.Close() //dummy
+	for i := 0; i < 2; i++ {
+		if err := rc.Next(); err != nil {
+			t.Fatalf("Next() is \"%+v\", want nil.", err)
+		}
+	}
+	_, err := rc.ColumnFloat64("name")
+	if !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("ColumnFloat64() is \"%+v\", want \"%+v\".", err, strconv.ErrSyntax)
+	}
+	var fe *csvdata.FieldError
+	if !errors.As(err, &fe) {
+		t.Fatalf("ColumnFloat64() is \"%+v\", want *csvdata.FieldError.", err)
+	}
+	want := csvdata.FieldError{Position: csvdata.Position{Line: 3, Column: 4}, Row: 2, Index: 1, Name: "name"}
+	if fe.Position != want.Position || fe.Row != want.Row || fe.Index != want.Index || fe.Name != want.Name {
+		t.Errorf("FieldError is %+v, want %+v.", fe, want)
+	}
+	if msg := fe.Error(); !strings.HasPrefix(msg, `line 3, column 4, row 2, field 1, "name": `) {
+		t.Errorf("FieldError.Error() is %q.", msg)
+	}
+}
+
+func TestRecordError(t *testing.T) {
+	rc := csvdata.NewRows(csvdata.New(strings.NewReader("a,b\n1,2\n3,\"4\"x\n")).WithLazyQuotes(false), true)
+	defer rc.Close() //dummy
+	if err := rc.Next(); err != nil {
+		t.Fatalf("Next() is \"%+v\", want nil.", err)
+	}
+	err := rc.Next()
+	if !errors.Is(err, csvdata.ErrInvalidRecord) {
+		t.Errorf("Next() is \"%+v\", want \"%+v\".", err, csvdata.ErrInvalidRecord)
+	}
+	var fe *csvdata.FieldError
+	if !errors.As(err, &fe) {
+		t.Fatalf("Next() is \"%+v\", want *csvdata.FieldError.", err)
+	}
+	if fe.Line != 3 || fe.Column != 5 || fe.Row != 2 || fe.Index != -1 {
+		t.Errorf("FieldError is %+v, want line 3, column 5 and row 2.", fe)
+	}
+}
+
+func TestCellName(t *testing.T) {
+	testCases := []struct {
+		col, row int
+		want     string
+	}{
+		{col: 1, row: 1, want: "A1"},
+		{col: 3, row: 7, want: "C7"},
+		{col: 26, row: 10, want: "Z10"},
+		{col: 27, row: 2, want: "AA2"},
+		{col: 16384, row: 1048576, want: "XFD1048576"},
+		{col: 0, row: 1, want: ""},
+	}
+	for _, tc := range testCases {
+		if got := csvdata.CellName(tc.col, tc.row); got != tc.want {
+			t.Errorf("CellName(%v, %v) is %q, want %q.", tc.col, tc.row, got, tc.want)
+		}
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -25,6 +25,24 @@ type Rows struct {
 	headerStrings []string
 	headerMap     map[string]int
 	rowdata       []string
+	rowIndex      int
+	single        bool // single value for parsing (no position)
+	lenient       bool
+	maxErrors     int
+	report        ErrorReport
+	schema        *Schema
+	headerChecked bool
+	err           error // error in iteration
+	dateSystem    *DateSystem
+	headerRows    int
+	headerJoiner  string
+	normalizer    HeaderNormalizer
+	aliases       [][]string     // groups of column name and aliases
+	aliasKeys     [][]string     // normalized aliases
+	lookup        map[string]int // normalized names and aliases to indexes in header (-1 if not found)
+	columns       map[string]int // cache of indexOf method (-1 if not found)
+	duplicate     DuplicatePolicy
+	headerErr     error // error in header (e.g. duplicate names)
 }
 
 func NewRows(rr RowsReader, headerFlag bool) *Rows {
@@ -49,12 +67,15 @@ func (r *Rows) Header() ([]string, error) {
 	var err error
 	if r.headerFlag {
 		r.headerFlag = false
-		r.headerStrings, err = r.reader.Read()
-		if len(r.headerStrings) > 0 {
-			for i, name := range r.headerStrings {
-				r.headerMap[strings.TrimSpace(name)] = i
+		r.headerStrings, err = r.readHeader()
+		if errMap := r.buildHeaderMap(); errMap != nil {
+			r.headerErr = errMap
+			if err == nil {
+				err = errMap
 			}
 		}
+	} else if r.headerErr != nil {
+		err = r.headerErr
 	}
 	return r.headerStrings, errs.Wrap(err)
 }
@@ -64,14 +85,39 @@ func (r *Rows) Next() error {
 	if r == nil {
 		return errs.Wrap(ErrNullPointer)
 	}
-	if r.headerFlag {
+	if r.headerFlag || r.headerErr != nil {
 		if _, err := r.Header(); err != nil {
 			return errs.Wrap(err)
 		}
 	}
-	var err error
-	r.rowdata, err = r.reader.Read()
-	return errs.Wrap(err)
+	if r.schema != nil && !r.headerChecked {
+		r.headerChecked = true
+		if err := r.validateHeader(); err != nil {
+			return errs.Wrap(err)
+		}
+	}
+	for {
+		var err error
+		r.rowdata, err = r.reader.Read()
+		r.rowIndex++
+		if err == nil {
+			if r.schema == nil {
+				return nil
+			}
+			if err = r.validateRow(); err == nil {
+				return nil
+			}
+		}
+		var fe *FieldError
+		if errs.As(err, &fe) && fe.Row == 0 {
+			fe.Row = r.rowIndex
+		}
+		if ok, errSkip := r.skip(err); errSkip != nil {
+			return errs.Wrap(errSkip)
+		} else if !ok {
+			return errs.Wrap(err)
+		}
+	}
 }
 
 // Row method returns current row data.
@@ -172,9 +218,12 @@ func (r *Rows) GetBool(i int) (bool, error) {
 	if len(s) == 0 {
 		return false, errs.Wrap(ErrNullValue)
 	}
+	if c, ok := r.cell(i); ok && c.Type == CellBool {
+		return c.Bool, nil
+	}
 	b, err := strconv.ParseBool(s)
 	if err != nil {
-		return false, errs.Wrap(err)
+		return false, r.fieldError(i, err)
 	}
 	return b, nil
 }
@@ -216,9 +265,12 @@ func (r *Rows) GetFloat64(i int) (float64, error) {
 	if len(s) == 0 {
 		return 0, errs.Wrap(ErrNullValue)
 	}
+	if c, ok := r.cell(i); ok && (c.Type == CellNumber || c.Type == CellDate) {
+		return c.Number, nil
+	}
 	f, err := strconv.ParseFloat(s, 64)
 	if err != nil {
-		return 0, errs.Wrap(err)
+		return 0, r.fieldError(i, err)
 	}
 	return f, nil
 }
@@ -262,7 +314,7 @@ func (r *Rows) GetInt64(i int, base int) (int64, error) {
 	}
 	n, err := strconv.ParseInt(s, base, 64)
 	if err != nil {
-		return 0, errs.Wrap(err)
+		return 0, r.fieldError(i, err)
 	}
 	return n, nil
 }
@@ -287,7 +339,7 @@ func (r *Rows) ColumnNullInt32(s string, base int) (sql.NullInt32, error) {
 		return sql.NullInt32{}, errs.Wrap(err)
 	}
 	if res.Valid && (res.Int64 < math.MinInt32 || res.Int64 > math.MaxInt32) {
-		return sql.NullInt32{}, errs.Wrap(strconv.ErrRange)
+		return sql.NullInt32{}, r.columnError(s, strconv.ErrRange)
 	}
 	return sql.NullInt32{Int32: int32(res.Int64 & 0xffffffff), Valid: true}, nil
 }
@@ -299,7 +351,7 @@ func (r *Rows) ColumnNullInt16(s string, base int) (sql.NullInt16, error) {
 		return sql.NullInt16{Valid: false}, errs.Wrap(err)
 	}
 	if res.Valid && (res.Int64 < math.MinInt16 || res.Int64 > math.MaxInt16) {
-		return sql.NullInt16{Valid: false}, errs.Wrap(strconv.ErrRange)
+		return sql.NullInt16{Valid: false}, r.columnError(s, strconv.ErrRange)
 	}
 	return sql.NullInt16{Int16: int16(res.Int64 & 0xffff), Valid: true}, nil
 }
@@ -311,7 +363,7 @@ func (r *Rows) ColumnNullByte(s string, base int) (sql.NullByte, error) {
 		return sql.NullByte{Valid: false}, errs.Wrap(err)
 	}
 	if res.Valid && (res.Int64 < 0 || res.Int64 > math.MaxUint8) {
-		return sql.NullByte{Valid: false}, errs.Wrap(strconv.ErrRange)
+		return sql.NullByte{Valid: false}, r.columnError(s, strconv.ErrRange)
 	}
 	return sql.NullByte{Byte: byte(res.Int64 & 0xff), Valid: true}, nil
 }
@@ -360,7 +412,7 @@ func (r *Rows) ColumnInt8(s string, base int) (int8, error) {
 	}
 	if res.Valid {
 		if res.Int16 < math.MinInt8 || res.Int16 > math.MaxInt8 {
-			return 0, errs.Wrap(strconv.ErrRange)
+			return 0, r.columnError(s, strconv.ErrRange)
 		}
 		return int8(res.Int16 & 0xff), nil
 	}
@@ -391,12 +443,18 @@ func (r *Rows) GetTime(i int, layout string) (time.Time, error) {
 	if len(s) == 0 {
 		return time.Time{}, errs.Wrap(ErrNullValue)
 	}
+	if c, ok := r.cell(i); ok && c.Type == CellDate {
+		return c.Time, nil
+	}
+	if layout == LayoutSerial {
+		return r.serialTime(i, s)
+	}
 	if len(layout) == 0 {
 		layout = time.RFC3339
 	}
 	tm, err := time.Parse(layout, s)
 	if err != nil {
-		return time.Time{}, errs.Wrap(err)
+		return time.Time{}, r.fieldError(i, err)
 	}
 	return tm, nil
 }
@@ -431,14 +489,67 @@ func (r *Rows) Close() error {
 	return r.reader.Close()
 }
 
+// ColumnIndex method returns index of column named s in header. Header normalizer and column aliases are applied.
+func (r *Rows) ColumnIndex(s string) (int, error) {
+	i, err := r.indexOf(s)
+	if err != nil {
+		return 0, errs.Wrap(err)
+	}
+	return i, nil
+}
+
 func (r *Rows) indexOf(s string) (int, error) {
 	if r == nil {
 		return 0, errs.Wrap(ErrNullPointer, errs.WithContext("column", s))
 	}
-	if i, ok := r.headerMap[strings.TrimSpace(s)]; ok {
-		return i, nil
+	i, ok := r.columns[s]
+	if !ok {
+		if r.lookup == nil {
+			r.buildLookup()
+		}
+		if i, ok = r.lookup[r.normalize(s)]; !ok {
+			i = -1
+		}
+		r.columns[s] = i
+	}
+	if i < 0 {
+		return 0, errs.Wrap(ErrOutOfIndex, errs.WithContext("column", s))
+	}
+	return i, nil
+}
+
+// fieldError returns error with position of i-th field in current row.
+func (r *Rows) fieldError(i int, err error) error {
+	fe := &FieldError{Index: -1, Err: err}
+	if !r.single {
+		fe.Row, fe.Index = r.rowIndex, i
+		if i >= 0 && i < len(r.headerStrings) {
+			fe.Name = strings.TrimSpace(r.headerStrings[i])
+		}
+		fe.Position = r.position(i)
+	}
+	opts := []errs.ErrorContextFunc{errs.WithContext("line", fe.Line), errs.WithContext("row", fe.Row), errs.WithContext("index", fe.Index), errs.WithContext("name", fe.Name)}
+	if len(fe.Cell) > 0 {
+		opts = append(opts, errs.WithContext("cell", fe.Cell))
+	}
+	return errs.Wrap(fe, opts...)
+}
+
+// position returns position of i-th field in current row.
+func (r *Rows) position(i int) Position {
+	if pr, ok := r.reader.(PositionReader); ok && !r.single {
+		return pr.Position(i)
+	}
+	return Position{}
+}
+
+// columnError returns error with position of the field named s in current row.
+func (r *Rows) columnError(s string, err error) error {
+	i, e := r.indexOf(s)
+	if e != nil {
+		return errs.Wrap(err, errs.WithContext("column", s))
 	}
-	return 0, errs.Wrap(ErrOutOfIndex, errs.WithContext("column", s))
+	return r.fieldError(i, err)
 }
 
 /* Copyright 2021-2022 Spiegel
@@ -0,0 +1,260 @@
+package csvdata
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/goark/errs"
+)
+
+// ColumnType is type of column value.
+type ColumnType int
+
+const (
+	TypeString ColumnType = iota
+	TypeBool
+	TypeInt
+	TypeFloat
+	TypeTime
+)
+
+var columnTypeNames = map[ColumnType]string{
+	TypeString: "string",
+	TypeBool:   "bool",
+	TypeInt:    "int",
+	TypeFloat:  "float",
+	TypeTime:   "time",
+}
+
+// String method is a implementation of fmt.Stringer interface.
+func (t ColumnType) String() string {
+	if s, ok := columnTypeNames[t]; ok {
+		return s
+	}
+	return "unknown"
+}
+
+// ColumnSchema is definition of a column.
+type ColumnSchema struct {
+	Name     string
+	Type     ColumnType
+	Required bool           // column must exist in header
+	Nullable bool           // empty value is allowed
+	Pattern  *regexp.Regexp // string value must match the pattern
+	Min, Max *float64       // range of value (TypeInt, TypeFloat), or range of length in runes (TypeString)
+	Enum     []string       // string value must be one of them
+	Layout   string         // layout of TypeTime value (default time.RFC3339)
+	Base     int            // base of TypeInt value (default 10)
+}
+
+// Schema is definition of columns in Rows.
+type Schema struct {
+	Columns []ColumnSchema
+	Strict  bool // header must not contain undefined columns
+}
+
+// Rules of schema violation
+const (
+	RuleRequired = "required" // required column is missing in header
+	RuleUnknown  = "unknown"  // undefined column in header (strict mode)
+	RuleNullable = "nullable" // value is empty but column is not nullable
+	RuleType     = "type"     // value can not be parsed as the type
+	RuleMin      = "min"      // value is less than minimum
+	RuleMax      = "max"      // value is greater than maximum
+	RulePattern  = "pattern"  // value does not match the pattern
+	RuleEnum     = "enum"     // value is not in enumeration
+)
+
+// Violation is a schema violation.
+type Violation struct {
+	Position
+	Row    int    // index of data row (1-based; 0 for header row)
+	Column string // column name
+	Rule   string // RuleXxx constant
+	Value  string // value of field
+	Err    error  // cause of violation (e.g. parsing error; may be nil)
+}
+
+// String method is a implementation of fmt.Stringer interface.
+func (v Violation) String() string {
+	elms := []string{}
+	if v.Line > 0 {
+		elms = append(elms, "line "+strconv.Itoa(v.Line))
+	}
+	if len(v.Cell) > 0 {
+		elms = append(elms, "cell "+v.Cell)
+	}
+	if v.Row > 0 {
+		elms = append(elms, "row "+strconv.Itoa(v.Row))
+	} else {
+		elms = append(elms, "header")
+	}
+	msg := strings.Join(elms, ", ") + ": " + strconv.Quote(v.Column) + " violates " + v.Rule + " rule"
+	if v.Row > 0 {
+		msg += fmt.Sprintf(" (value %q)", v.Value)
+	}
+	if v.Err != nil {
+		msg += ": " + v.Err.Error()
+	}
+	return msg
+}
+
+// ValidationError is error with schema violations.
+type ValidationError struct {
+	Violations []Violation
+}
+
+// Error method returns messages of all violations.
+// This method is a implementation of error interface.
+func (e *ValidationError) Error() string {
+	if e == nil {
+		return "<nil>"
+	}
+	msgs := []string{ErrSchemaViolation.Error()}
+	for _, v := range e.Violations {
+		msgs = append(msgs, v.String())
+	}
+	return strings.Join(msgs, "\n\t")
+}
+
+// Unwrap method returns ErrSchemaViolation.
+// This method is used in errors.Unwrap function.
+func (e *ValidationError) Unwrap() error {
+	return ErrSchemaViolation
+}
+
+// WithSchema method sets schema for validation. Header is validated at the first Next method call, and each row afterwards.
+// Next method returns *ValidationError if violations are found (current row data remains available), or skips the row in lenient mode.
+func (r *Rows) WithSchema(s *Schema) *Rows {
+	if r == nil {
+		return nil
+	}
+	r.schema = s
+	return r
+}
+
+// validateHeader method validates header by schema.
+func (r *Rows) validateHeader() error {
+	vs := []Violation{}
+	defined := map[string]bool{}
+	for _, c := range r.schema.Columns {
+		for _, key := range r.columnKeys(c.Name) {
+			defined[key] = true
+		}
+		if _, err := r.indexOf(c.Name); err != nil && c.Required {
+			vs = append(vs, Violation{Column: c.Name, Rule: RuleRequired})
+		}
+	}
+	if r.schema.Strict {
+		for _, name := range r.headerStrings {
+			if !defined[r.normalize(name)] {
+				name = strings.TrimSpace(name)
+				vs = append(vs, Violation{Column: name, Rule: RuleUnknown, Value: name})
+			}
+		}
+	}
+	if len(vs) > 0 {
+		return errs.Wrap(&ValidationError{Violations: vs})
+	}
+	return nil
+}
+
+// validateRow method validates current row by schema.
+func (r *Rows) validateRow() error {
+	vs := []Violation{}
+	for i := range r.schema.Columns {
+		if v, ok := r.validateColumn(&r.schema.Columns[i]); !ok {
+			vs = append(vs, v)
+		}
+	}
+	if len(vs) > 0 {
+		return errs.Wrap(&ValidationError{Violations: vs}, errs.WithContext("row", r.rowIndex))
+	}
+	return nil
+}
+
+func (r *Rows) validateColumn(c *ColumnSchema) (Violation, bool) {
+	i, err := r.indexOf(c.Name)
+	if err != nil {
+		return Violation{}, true
+	}
+	s, _ := r.GetString(i)
+	v := Violation{Position: r.position(i), Row: r.rowIndex, Column: c.Name, Value: s}
+	if r.isNull(i) {
+		if c.Nullable {
+			return v, true
+		}
+		v.Rule = RuleNullable
+		return v, false
+	}
+	var num float64
+	switch c.Type {
+	case TypeBool:
+		_, err = r.GetBool(i)
+	case TypeInt:
+		base := c.Base
+		if base == 0 {
+			base = 10
+		}
+		var n int64
+		n, err = r.GetInt64(i, base)
+		num = float64(n)
+	case TypeFloat:
+		num, err = r.GetFloat64(i)
+	case TypeTime:
+		_, err = r.GetTime(i, c.Layout)
+	default:
+		num = float64(utf8.RuneCountInString(s))
+	}
+	if err != nil {
+		v.Rule, v.Err = RuleType, err
+		var fe *FieldError
+		if errs.As(err, &fe) {
+			v.Err = fe.Err
+		}
+		return v, false
+	}
+	switch c.Type {
+	case TypeInt, TypeFloat, TypeString:
+		if c.Min != nil && num < *c.Min {
+			v.Rule = RuleMin
+			return v, false
+		}
+		if c.Max != nil && num > *c.Max {
+			v.Rule = RuleMax
+			return v, false
+		}
+	}
+	if c.Pattern != nil && !c.Pattern.MatchString(s) {
+		v.Rule = RulePattern
+		return v, false
+	}
+	if len(c.Enum) > 0 {
+		for _, e := range c.Enum {
+			if s == e {
+				return v, true
+			}
+		}
+		v.Rule = RuleEnum
+		return v, false
+	}
+	return v, true
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,124 @@
+package csvdata_test
+
+import (
+	"errors"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/goark/csvdata"
+)
+
+func float64Ptr(f float64) *float64 { return &f }
+
+var planetSchema = &csvdata.Schema{
+	Columns: []csvdata.ColumnSchema{
+		{Name: "order", Type: csvdata.TypeInt, Required: true, Min: float64Ptr(1), Max: float64Ptr(8)},
+		{Name: "name", Type: csvdata.TypeString, Required: true, Pattern: regexp.MustCompile(`^[A-Z][a-z]+$`)},
+		{Name: "mass", Type: csvdata.TypeFloat, Nullable: true},
+		{Name: "habitable", Type: csvdata.TypeBool},
+		{Name: "type", Type: csvdata.TypeString, Nullable: true, Enum: []string{"rocky", "gas"}},
+		{Name: "date", Type: csvdata.TypeTime, Nullable: true, Layout: "2006-01-02"},
+	},
+}
+
+const csvSchema = `order,name,mass,habitable,type,date
+1,Mercury,0.055,false,rocky,
+9,venus,heavy,false,ice,2023/01/01
+3,Earth,,,rocky,2023-01-01
+`
+
+func TestSchema(t *testing.T) {
+	rc := csvdata.NewRows(csvdata.New(strings.NewReader(csvSchema)), true).WithSchema(planetSchema)
+	defer rc.Close() //dummy
+	if err := rc.Next(); err != nil {
+		t.Fatalf("Next() is \"%+v\", want nil.", err)
+	}
+	err := rc.Next()
+	if !errors.Is(err, csvdata.ErrSchemaViolation) {
+		t.Fatalf("Next() is \"%+v\", want \"%+v\".", err, csvdata.ErrSchemaViolation)
+	}
+	var verr *csvdata.ValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("Next() is \"%+v\", want *csvdata.ValidationError.", err)
+	}
+	want := []csvdata.Violation{
+		{Position: csvdata.Position{Line: 3, Column: 1}, Row: 2, Column: "order", Rule: csvdata.RuleMax, Value: "9"},
+		{Position: csvdata.Position{Line: 3, Column: 3}, Row: 2, Column: "name", Rule: csvdata.RulePattern, Value: "venus"},
+		{Position: csvdata.Position{Line: 3, Column: 9}, Row: 2, Column: "mass", Rule: csvdata.RuleType, Value: "heavy"},
+		{Position: csvdata.Position{Line: 3, Column: 21}, Row: 2, Column: "type", Rule: csvdata.RuleEnum, Value: "ice"},
+		{Position: csvdata.Position{Line: 3, Column: 25}, Row: 2, Column: "date", Rule: csvdata.RuleType, Value: "2023/01/01"},
+	}
+	if len(verr.Violations) != len(want) {
+		t.Fatalf("Violations is %v, want %v.", verr.Violations, want)
+	}
+	for i, v := range verr.Violations {
+		e := v.Err
+		v.Err = nil
+		if v != want[i] {
+			t.Errorf("Violations[%d] is %+v, want %+v.", i, v, want[i])
+		}
+		if i == 2 && !errors.Is(e, strconv.ErrSyntax) {
+			t.Errorf("Violations[%d].Err is \"%+v\", want \"%+v\".", i, e, strconv.ErrSyntax)
+		}
+	}
+	if s := rc.Column("name"); s != "venus" {
+		t.Errorf("Column() is %q, want %q.", s, "venus")
+	}
+	err = rc.Next()
+	if !errors.As(err, &verr) || len(verr.Violations) != 1 || verr.Violations[0].Rule != csvdata.RuleNullable || verr.Violations[0].Column != "habitable" {
+		t.Errorf("Next() is \"%+v\", want nullable violation.", err)
+	}
+	if err := rc.Next(); !errors.Is(err, io.EOF) {
+		t.Errorf("Next() is \"%+v\", want \"%+v\".", err, io.EOF)
+	}
+}
+
+func TestSchemaHeader(t *testing.T) {
+	schema := &csvdata.Schema{Columns: []csvdata.ColumnSchema{{Name: "order", Required: true}, {Name: "id", Required: true}}, Strict: true}
+	rc := csvdata.NewRows(csvdata.New(strings.NewReader(csvSchema)), true).WithSchema(schema)
+	defer rc.Close() //dummy
+	err := rc.Next()
+	var verr *csvdata.ValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("Next() is \"%+v\", want *csvdata.ValidationError.", err)
+	}
+	if len(verr.Violations) != 6 || verr.Violations[0].Rule != csvdata.RuleRequired || verr.Violations[0].Column != "id" || verr.Violations[1].Rule != csvdata.RuleUnknown {
+		t.Errorf("Violations is %v.", verr.Violations)
+	}
+}
+
+func TestSchemaLenient(t *testing.T) {
+	rc := csvdata.NewRows(csvdata.New(strings.NewReader(csvSchema)), true).WithSchema(planetSchema).WithLenient(-1)
+	defer rc.Close() //dummy
+	list := []string{}
+	for {
+		if err := rc.Next(); err != nil {
+			if !errors.Is(err, io.EOF) {
+				t.Errorf("Next() is \"%+v\", want \"%+v\".", err, io.EOF)
+			}
+			break
+		}
+		list = append(list, rc.Column("name"))
+	}
+	if len(list) != 1 || list[0] != "Mercury" || len(rc.Errors()) != 2 || !errors.Is(rc.Errors()[0], csvdata.ErrSchemaViolation) {
+		t.Errorf("rows are %v, errors are %v.", list, rc.Errors())
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
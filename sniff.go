@@ -0,0 +1,245 @@
+package csvdata
+
+import (
+	"bytes"
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/goark/errs"
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/unicode"
+	"golang.org/x/text/transform"
+)
+
+// Dialect is CSV dialect detected by SniffDialect function.
+type Dialect struct {
+	Comma      rune // field delimiter
+	LazyQuotes bool // true if a quote appears in an unquoted field or a non-doubled quote appears in a quoted field
+	Header     bool // true if the first row looks like a header
+}
+
+// SniffSize is size of sample data inspected by Sniff function.
+const SniffSize = 64 * 1024
+
+const sniffRecords = 100
+
+var sniffDelimiters = []rune{',', '\t', ';', '|'}
+
+// Sniff function inspects head of r, and returns Reader instance configured by detected dialect and header flag for NewRows function.
+func Sniff(r io.Reader) (*Reader, bool, error) {
+	buf := make([]byte, SniffSize)
+	n, err := io.ReadFull(r, buf)
+	if err != nil && !errs.Is(err, io.EOF) && !errs.Is(err, io.ErrUnexpectedEOF) {
+		return nil, false, errs.Wrap(err)
+	}
+	sample := buf[:n]
+	d := SniffDialect(sample, n < SniffSize)
+	var src io.Reader = io.MultiReader(bytes.NewReader(sample), r)
+	if c, ok := r.(io.Closer); ok {
+		src = struct {
+			io.Reader
+			io.Closer
+		}{src, c}
+	}
+	return New(src).WithComma(d.Comma).WithLazyQuotes(d.LazyQuotes), d.Header, nil
+}
+
+// NewAuto function returns Rows instance for r with dialect detected by Sniff function.
+func NewAuto(r io.Reader) (*Rows, error) {
+	rr, header, err := Sniff(r)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return NewRows(rr, header), nil
+}
+
+// SniffDialect function detects dialect from sample data. If complete is false, the last line in sample is ignored because it may be truncated.
+func SniffDialect(sample []byte, complete bool) Dialect {
+	if b, _, err := transform.Bytes(unicode.BOMOverride(encoding.Nop.NewDecoder()), sample); err == nil {
+		sample = b
+	}
+	text := string(sample)
+	if !complete {
+		if i := strings.LastIndexByte(text, '\n'); i >= 0 {
+			text = text[:i+1]
+		}
+	}
+	d := Dialect{Comma: ',', LazyQuotes: false}
+	best := 0.0
+	for _, c := range sniffDelimiters {
+		records := sniffRead(text, c)
+		if score := consistency(records); score > best {
+			d.Comma, best = c, score
+		}
+	}
+	d.LazyQuotes = needsLazyQuotes(text, d.Comma, complete)
+	d.Header = hasHeader(sniffRead(text, d.Comma))
+	return d
+}
+
+// needsLazyQuotes reports whether text can not be parsed with strict quoting rules.
+func needsLazyQuotes(text string, comma rune, complete bool) bool {
+	cr := csv.NewReader(strings.NewReader(text))
+	cr.Comma = comma
+	cr.TrimLeadingSpace = true
+	cr.FieldsPerRecord = -1
+	for i := 0; i < sniffRecords; i++ {
+		_, err := cr.Read()
+		if err == nil {
+			continue
+		}
+		if errs.Is(err, io.EOF) {
+			return false
+		}
+		if !complete && errs.Is(err, csv.ErrQuote) {
+			// quoted field may be truncated at the end of sample
+			if _, err := cr.Read(); errs.Is(err, io.EOF) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+func sniffRead(text string, comma rune) [][]string {
+	cr := csv.NewReader(strings.NewReader(text))
+	cr.Comma = comma
+	cr.LazyQuotes = true
+	cr.TrimLeadingSpace = true
+	cr.FieldsPerRecord = -1
+	records := [][]string{}
+	for len(records) < sniffRecords {
+		rec, err := cr.Read()
+		if err != nil {
+			break
+		}
+		records = append(records, rec)
+	}
+	return records
+}
+
+// consistency returns score of delimiter: ratio of records that have the most frequent number of fields (2 or more).
+func consistency(records [][]string) float64 {
+	if len(records) == 0 {
+		return 0
+	}
+	counts := map[int]int{}
+	for _, rec := range records {
+		counts[len(rec)]++
+	}
+	mode, freq := 0, 0
+	for size, n := range counts {
+		if n > freq || (n == freq && size > mode) {
+			mode, freq = size, n
+		}
+	}
+	if mode < 2 {
+		return 0
+	}
+	// prefer more fields if consistency is the same
+	return float64(freq)/float64(len(records)) + float64(mode)/1e6
+}
+
+type valueKind int
+
+const (
+	kindEmpty valueKind = iota
+	kindBool
+	kindInt
+	kindFloat
+	kindString
+)
+
+func kindOf(s string) valueKind {
+	s = strings.TrimSpace(s)
+	if len(s) == 0 {
+		return kindEmpty
+	}
+	if _, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return kindInt
+	}
+	if _, err := strconv.ParseFloat(s, 64); err == nil {
+		return kindFloat
+	}
+	if _, err := strconv.ParseBool(s); err == nil {
+		return kindBool
+	}
+	return kindString
+}
+
+// hasHeader reports whether the first record looks like a header by comparing with following records column by column.
+func hasHeader(records [][]string) bool {
+	if len(records) < 2 {
+		return false
+	}
+	header := records[0]
+	names := map[string]bool{}
+	for _, name := range header {
+		name = strings.TrimSpace(name)
+		if len(name) == 0 || names[name] {
+			return false
+		}
+		names[name] = true
+	}
+	votes := 0
+	for i, name := range header {
+		kind, length, mixed := kindEmpty, -1, false
+		for _, rec := range records[1:] {
+			if i >= len(rec) {
+				continue
+			}
+			k := kindOf(rec[i])
+			if k == kindEmpty {
+				continue
+			}
+			if kind == kindEmpty {
+				kind = k
+			} else if kind != k {
+				if (kind == kindInt && k == kindFloat) || (kind == kindFloat && k == kindInt) {
+					kind = kindFloat
+				} else {
+					mixed = true
+				}
+			}
+			if length == -1 {
+				length = len(rec[i])
+			} else if length != len(rec[i]) {
+				length = -2
+			}
+		}
+		switch {
+		case mixed || kind == kindEmpty:
+		case kind != kindString:
+			if hk := kindOf(name); hk != kind && !(kind == kindFloat && hk == kindInt) {
+				votes++
+			} else {
+				votes--
+			}
+		case length >= 0:
+			if len(name) != length {
+				votes++
+			} else {
+				votes--
+			}
+		}
+	}
+	return votes > 0
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
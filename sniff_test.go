@@ -0,0 +1,71 @@
+package csvdata_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/goark/csvdata"
+)
+
+func TestSniffDialect(t *testing.T) {
+	testCases := []struct {
+		name string
+		inp  string
+		want csvdata.Dialect
+	}{
+		{name: "comma with header", inp: csv1, want: csvdata.Dialect{Comma: ',', LazyQuotes: false, Header: true}},
+		{name: "tab without header", inp: tsv1, want: csvdata.Dialect{Comma: '\t', LazyQuotes: false, Header: false}},
+		{name: "semicolon", inp: "id;name;price\n1;Käse;1,50\n2;Brot;2,25\n", want: csvdata.Dialect{Comma: ';', LazyQuotes: false, Header: true}},
+		{name: "pipe", inp: "1|a b|x\n2|c d|y\n", want: csvdata.Dialect{Comma: '|', LazyQuotes: false, Header: false}},
+		{name: "bare quote", inp: "order,name\n1,5\" disk\n2,\"3.5\" disk\"\n", want: csvdata.Dialect{Comma: ',', LazyQuotes: true, Header: true}},
+		{name: "single column", inp: "foo\nbar\n", want: csvdata.Dialect{Comma: ',', LazyQuotes: false, Header: false}},
+		{name: "BOM", inp: "\ufeffa\tb\n1\t2\n", want: csvdata.Dialect{Comma: '\t', LazyQuotes: false, Header: true}},
+	}
+	for _, tc := range testCases {
+		if got := csvdata.SniffDialect([]byte(tc.inp), true); got != tc.want {
+			t.Errorf("%s: SniffDialect() is %+v, want %+v.", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestSniffTruncated(t *testing.T) {
+	inp := "id;note\n1;\"multi\nline\"\n2;\"trunc"
+	if got := csvdata.SniffDialect([]byte(inp), false); got.Comma != ';' || got.LazyQuotes {
+		t.Errorf("SniffDialect() is %+v, want %q and strict quoting.", got, ';')
+	}
+}
+
+func TestNewAuto(t *testing.T) {
+	rc, err := csvdata.NewAuto(strings.NewReader("id;name;price\n1;Käse;1,50\n2;Brot;2,25\n"))
+	if err != nil {
+		t.Fatalf("NewAuto() is \"%+v\", want nil.", err)
+	}
+	defer rc.Close()
+	if err := rc.Next(); err != nil {
+		t.Fatalf("Next() is \"%+v\", want nil.", err)
+	}
+	if s := rc.Column("price"); s != "1,50" {
+		t.Errorf("Column() is %q, want %q.", s, "1,50")
+	}
+	errtest := errors.New("test")
+	if _, err := csvdata.NewAuto(iotest.ErrReader(errtest)); !errors.Is(err, errtest) {
+		t.Errorf("NewAuto() is \"%+v\", want \"%+v\".", err, errtest)
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,328 @@
+// Package sqldriver is database/sql driver for reading CSV, Excel and LibreOffice Calc files as read-only tables.
+//
+//	import _ "github.com/goark/csvdata/sqldriver"
+//
+//	db, err := sql.Open("csvdata", "file=planets.csv;header=true")
+//	rows, err := db.Query("SELECT name, mass FROM planets WHERE mass > ? AND habitable = true", 0.5)
+//
+// Data source name is list of "key=value" separated by semicolon:
+//
+//   - file: path of CSV (.csv, .tsv and others), Excel (.xlsx, .xlsm) or Calc (.ods) file (required)
+//   - header: first row is header (default true)
+//   - sheet: sheet name (or range of cells in Excel file) for the table named after the file (default the first sheet)
+//   - comma: field delimiter of CSV file ("tab" for tab character; default comma, or tab for .tsv file)
+//   - trim: trim spaces of fields in CSV file (default false)
+//   - infer: number of rows for inferring column types (default 100; 0 means all columns are TEXT)
+//
+// The table name in FROM clause is base name of the file without extension (e.g. "planets"), or sheet name of spreadsheet.
+// Supported SQL is SELECT statement with column projection, WHERE clause (comparison, LIKE, IN, IS NULL, AND, OR and NOT) and LIMIT clause.
+// Empty values are NULL, and values are scanned into sql.NullXxx types.
+package sqldriver
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/goark/csvdata"
+	"github.com/goark/csvdata/calcdata"
+	"github.com/goark/csvdata/exceldata"
+	"github.com/goark/errs"
+	"github.com/xuri/excelize/v2"
+)
+
+// DriverName is name of the driver registered in database/sql package.
+const DriverName = "csvdata"
+
+func init() {
+	sql.Register(DriverName, &Driver{})
+}
+
+// Driver is database/sql driver for CSV, Excel and LibreOffice Calc files.
+type Driver struct{}
+
+var _ driver.Driver = (*Driver)(nil) //Driver is compatible with driver.Driver interface
+
+// Open method returns a new connection to the file in data source name.
+// This method is a implementation of driver.Driver interface.
+func (d *Driver) Open(dsn string) (driver.Conn, error) {
+	cfg, err := parseDSN(dsn)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	if _, err := os.Stat(cfg.file); err != nil {
+		return nil, errs.Wrap(err, errs.WithContext("file", cfg.file))
+	}
+	return &conn{cfg: cfg}, nil
+}
+
+// config is parsed data source name.
+type config struct {
+	file   string
+	header bool
+	sheet  string
+	comma  rune
+	trim   bool
+	infer  int
+}
+
+func parseDSN(dsn string) (*config, error) {
+	cfg := &config{header: true, infer: 100}
+	for _, elm := range strings.Split(dsn, ";") {
+		elm = strings.TrimSpace(elm)
+		if len(elm) == 0 {
+			continue
+		}
+		key, value, ok := strings.Cut(elm, "=")
+		if !ok {
+			return nil, errs.Wrap(ErrInvalidDSN, errs.WithContext("dsn", dsn), errs.WithContext("element", elm))
+		}
+		key, value = strings.ToLower(strings.TrimSpace(key)), strings.TrimSpace(value)
+		var err error
+		switch key {
+		case "file":
+			cfg.file = value
+		case "header":
+			cfg.header, err = strconv.ParseBool(value)
+		case "sheet":
+			cfg.sheet = value
+		case "comma":
+			switch {
+			case strings.EqualFold(value, "tab"):
+				cfg.comma = '\t'
+			case utf8.RuneCountInString(value) == 1:
+				cfg.comma, _ = utf8.DecodeRuneInString(value)
+			default:
+				err = csvdata.ErrInvalidDelimiter
+			}
+		case "trim":
+			cfg.trim, err = strconv.ParseBool(value)
+		case "infer":
+			cfg.infer, err = strconv.Atoi(value)
+		default:
+			err = ErrInvalidDSN
+		}
+		if err != nil {
+			return nil, errs.Wrap(ErrInvalidDSN, errs.WithCause(err), errs.WithContext("dsn", dsn), errs.WithContext("key", key))
+		}
+	}
+	if len(cfg.file) == 0 {
+		return nil, errs.Wrap(ErrInvalidDSN, errs.WithContext("dsn", dsn), errs.WithContext("reason", "no file"))
+	}
+	return cfg, nil
+}
+
+// conn is connection to a file.
+type conn struct {
+	cfg  *config
+	xlsx *excelize.File // opened at the first query for Excel file
+}
+
+var _ driver.Conn = (*conn)(nil) //conn is compatible with driver.Conn interface
+
+// Prepare method returns prepared statement.
+// This method is a implementation of driver.Conn interface.
+func (c *conn) Prepare(query string) (driver.Stmt, error) {
+	q, err := parse(query)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &stmt{conn: c, query: q}, nil
+}
+
+// Close method closes the connection.
+// This method is a implementation of driver.Conn interface.
+func (c *conn) Close() error {
+	if c.xlsx == nil {
+		return nil
+	}
+	return errs.Wrap(c.xlsx.Close())
+}
+
+// Begin method returns ErrReadOnly error.
+// This method is a implementation of driver.Conn interface.
+func (c *conn) Begin() (driver.Tx, error) {
+	return nil, errs.Wrap(ErrReadOnly)
+}
+
+func (c *conn) ext() string {
+	return strings.ToLower(filepath.Ext(c.cfg.file))
+}
+
+// sheet method returns sheet name for table name.
+func (c *conn) sheet(table string) (string, error) {
+	base := filepath.Base(c.cfg.file)
+	if strings.EqualFold(table, strings.TrimSuffix(base, filepath.Ext(base))) {
+		return c.cfg.sheet, nil
+	}
+	switch c.ext() {
+	case ".xlsx", ".xlsm", ".ods":
+		return table, nil
+	}
+	return "", errs.Wrap(ErrUnknownTable, errs.WithContext("table", table))
+}
+
+// open method returns reader of table.
+func (c *conn) open(table string) (csvdata.RowsReader, error) {
+	sheet, err := c.sheet(table)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	trim := csvdata.TrimTrailingRows | csvdata.TrimTrailingColumns
+	switch c.ext() {
+	case ".xlsx", ".xlsm":
+		if c.xlsx == nil {
+			if c.xlsx, err = exceldata.OpenFile(c.cfg.file, ""); err != nil {
+				return nil, errs.Wrap(err)
+			}
+		}
+		r, err := exceldata.New(c.xlsx, sheet)
+		if err != nil {
+			return nil, errs.Wrap(ErrUnknownTable, errs.WithCause(err), errs.WithContext("table", table))
+		}
+		return r.WithRawValue(true).WithTrim(trim), nil
+	case ".ods":
+		r, err := calcdata.OpenStream(c.cfg.file, sheet)
+		if err != nil {
+			if errs.Is(err, csvdata.ErrInvalidSheetName) {
+				return nil, errs.Wrap(ErrUnknownTable, errs.WithCause(err), errs.WithContext("table", table))
+			}
+			return nil, errs.Wrap(err)
+		}
+		return r.WithTrim(trim), nil
+	}
+	file, err := csvdata.OpenFile(c.cfg.file)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	r := csvdata.New(file).WithTrimSpace(c.cfg.trim)
+	switch {
+	case c.cfg.comma != 0:
+		r = r.WithComma(c.cfg.comma)
+	case c.ext() == ".tsv":
+		r = r.WithComma('\t')
+	}
+	return r, nil
+}
+
+// query method executes query and returns result set.
+func (c *conn) query(q *query, args []driver.Value) (driver.Rows, error) {
+	if len(args) != q.params {
+		return nil, errs.Wrap(ErrArguments, errs.WithContext("args", len(args)), errs.WithContext("params", q.params))
+	}
+	var profile *csvdata.Profile
+	if c.cfg.header && c.cfg.infer > 0 {
+		rr, err := c.open(q.table)
+		if err != nil {
+			return nil, errs.Wrap(err)
+		}
+		profile, err = csvdata.Infer(rr, c.cfg.infer)
+		_ = rr.Close()
+		if err != nil && !errs.Is(err, io.EOF) {
+			return nil, errs.Wrap(err)
+		}
+	}
+	rr, err := c.open(q.table)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	rc := csvdata.NewRows(rr, c.cfg.header)
+	table, pending, err := columns(rc, profile)
+	if err != nil {
+		_ = rc.Close()
+		return nil, errs.Wrap(err)
+	}
+	r, err := newRows(rc, table, q, args)
+	if err != nil {
+		_ = rc.Close()
+		return nil, errs.Wrap(err)
+	}
+	r.pending = pending
+	return r, nil
+}
+
+// columns function returns columns of table. Columns are named "column_1", "column_2" and so on if rc has no header,
+// and then the first row is read for counting columns (pending is true).
+func columns(rc *csvdata.Rows, profile *csvdata.Profile) ([]column, bool, error) {
+	table := []column{}
+	if profile != nil {
+		for _, c := range profile.Columns {
+			table = append(table, column{name: c.Name, typ: c.Type, layout: c.Layout})
+		}
+		return table, false, nil
+	}
+	header, err := rc.Header()
+	if err != nil && !errs.Is(err, io.EOF) {
+		return nil, false, errs.Wrap(err)
+	}
+	pending := false
+	if header == nil {
+		if err := rc.Next(); err != nil {
+			if !errs.Is(err, io.EOF) {
+				return nil, false, errs.Wrap(err)
+			}
+		} else {
+			pending = true
+			header = make([]string, len(rc.Row()))
+			for i := range header {
+				header[i] = "column_" + strconv.Itoa(i+1)
+			}
+		}
+	}
+	for _, name := range header {
+		table = append(table, column{name: strings.TrimSpace(name)})
+	}
+	return table, pending, nil
+}
+
+// stmt is prepared statement.
+type stmt struct {
+	conn  *conn
+	query *query
+}
+
+var _ driver.Stmt = (*stmt)(nil) //stmt is compatible with driver.Stmt interface
+
+// Close method is dummy.
+// This method is a implementation of driver.Stmt interface.
+func (s *stmt) Close() error {
+	return nil
+}
+
+// NumInput method returns number of placeholders.
+// This method is a implementation of driver.Stmt interface.
+func (s *stmt) NumInput() int {
+	return s.query.params
+}
+
+// Exec method returns ErrReadOnly error.
+// This method is a implementation of driver.Stmt interface.
+func (s *stmt) Exec([]driver.Value) (driver.Result, error) {
+	return nil, errs.Wrap(ErrReadOnly)
+}
+
+// Query method executes query with arguments.
+// This method is a implementation of driver.Stmt interface.
+func (s *stmt) Query(args []driver.Value) (driver.Rows, error) {
+	return s.conn.query(s.query, args)
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
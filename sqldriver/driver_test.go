@@ -0,0 +1,162 @@
+package sqldriver_test
+
+import (
+	"database/sql"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/goark/csvdata/sqldriver"
+)
+
+type planet struct {
+	Name      sql.NullString
+	Mass      sql.NullFloat64
+	Habitable sql.NullBool
+}
+
+func queryPlanets(t *testing.T, dsn, query string, args ...any) ([]planet, error) {
+	t.Helper()
+	db, err := sql.Open(sqldriver.DriverName, dsn)
+	if err != nil {
+		t.Fatalf("sql.Open() is \"%+v\", want nil.", err)
+	}
+	defer db.Close()
+	rows, err := db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	list := []planet{}
+	for rows.Next() {
+		var p planet
+		if err := rows.Scan(&p.Name, &p.Mass, &p.Habitable); err != nil {
+			return nil, err
+		}
+		list = append(list, p)
+	}
+	return list, rows.Err()
+}
+
+func names(list []planet) []string {
+	s := []string{}
+	for _, p := range list {
+		s = append(s, p.Name.String)
+	}
+	return s
+}
+
+func TestQuery(t *testing.T) {
+	testCases := []struct {
+		dsn   string
+		query string
+		args  []any
+		want  []string
+	}{
+		{dsn: "file=../testdata/sample.csv;trim=true", query: "SELECT name, mass, habitable FROM sample", want: []string{"Mercury", "Venus", "Earth", "Mars"}},
+		{dsn: "file=../testdata/sample.csv;trim=true", query: "SELECT name, mass, habitable FROM sample WHERE mass > ? AND habitable = false", args: []any{0.1}, want: []string{"Venus", "Mars"}},
+		{dsn: "file=../testdata/sample.csv;trim=true", query: "select NAME, Mass, habitable from SAMPLE where not (mass < 0.5 or name = 'Earth') limit 5", want: []string{"Venus"}},
+		{dsn: "file=../testdata/sample.csv;trim=true", query: "SELECT name, mass, habitable FROM sample WHERE name LIKE 'M%' OR \"order\" IN (3, 4) LIMIT 2", want: []string{"Mercury", "Earth"}},
+		{dsn: "file=../testdata/sample.csv;trim=true;infer=0", query: "SELECT name, mass, habitable FROM sample WHERE mass >= 1", want: []string{"Earth"}},
+		{dsn: "file=../testdata/sample.csv;trim=true;header=false", query: "SELECT column_2, column_3, column_5 FROM sample WHERE column_1 = 2 OR column_4 IS NULL", want: []string{"Venus"}},
+		{dsn: "file=../exceldata/testdata/sample.xlsx", query: "SELECT name, mass, habitable FROM sample WHERE habitable = TRUE", want: []string{" Earth"}},
+		{dsn: "file=../calcdata/testdata/sample.ods", query: "SELECT name, mass, habitable FROM sample WHERE mass < 0.1", want: []string{" Mercury"}},
+	}
+	for _, tc := range testCases {
+		list, err := queryPlanets(t, tc.dsn, tc.query, tc.args...)
+		if err != nil {
+			t.Errorf("Query(%q) is \"%+v\", want nil.", tc.query, err)
+			continue
+		}
+		if got := names(list); !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("Query(%q) is %q, want %q.", tc.query, got, tc.want)
+		}
+	}
+}
+
+func TestQueryTypes(t *testing.T) {
+	db, err := sql.Open(sqldriver.DriverName, "file=../testdata/sample.csv;trim=true")
+	if err != nil {
+		t.Fatalf("sql.Open() is \"%+v\", want nil.", err)
+	}
+	defer db.Close()
+	rows, err := db.Query("SELECT * FROM sample LIMIT 1")
+	if err != nil {
+		t.Fatalf("Query() is \"%+v\", want nil.", err)
+	}
+	defer rows.Close()
+	types, err := rows.ColumnTypes()
+	if err != nil {
+		t.Fatalf("ColumnTypes() is \"%+v\", want nil.", err)
+	}
+	got := []string{}
+	for _, ct := range types {
+		got = append(got, ct.Name()+":"+ct.DatabaseTypeName())
+	}
+	want := []string{"order:INTEGER", "name:TEXT", "mass:REAL", "distance:REAL", "habitable:BOOLEAN"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ColumnTypes() is %q, want %q.", got, want)
+	}
+	if !rows.Next() {
+		t.Fatalf("Next() is false, want true.")
+	}
+	var order sql.NullInt64
+	var name string
+	var mass, distance float64
+	var habitable bool
+	if err := rows.Scan(&order, &name, &mass, &distance, &habitable); err != nil {
+		t.Fatalf("Scan() is \"%+v\", want nil.", err)
+	}
+	if order.Int64 != 1 || name != "Mercury" || mass != 0.055 || distance != 0.4 || habitable {
+		t.Errorf("Scan() is %v %v %v %v %v.", order, name, mass, distance, habitable)
+	}
+}
+
+func TestQueryError(t *testing.T) {
+	testCases := []struct {
+		dsn   string
+		query string
+		args  []any
+		err   error
+	}{
+		{dsn: "header=true", query: "SELECT * FROM sample", err: sqldriver.ErrInvalidDSN},
+		{dsn: "file=../testdata/sample.csv;unknown=1", query: "SELECT * FROM sample", err: sqldriver.ErrInvalidDSN},
+		{dsn: "file=../testdata/sample.csv", query: "SELECT * FROM planets", err: sqldriver.ErrUnknownTable},
+		{dsn: "file=../testdata/sample.csv", query: "SELECT radius FROM sample", err: sqldriver.ErrUnknownColumn},
+		{dsn: "file=../testdata/sample.csv", query: "SELECT * FROM sample WHERE radius > 1", err: sqldriver.ErrUnknownColumn},
+		{dsn: "file=../testdata/sample.csv", query: "SELECT * FROM sample WHERE", err: sqldriver.ErrSyntax},
+		{dsn: "file=../testdata/sample.csv", query: "DELETE FROM sample", err: sqldriver.ErrSyntax},
+		{dsn: "file=../calcdata/testdata/sample.ods", query: "SELECT * FROM Sheet9", err: sqldriver.ErrUnknownTable},
+	}
+	for _, tc := range testCases {
+		if _, err := queryPlanets(t, tc.dsn, tc.query, tc.args...); !errors.Is(err, tc.err) {
+			t.Errorf("Query(%q, %q) is \"%+v\", want \"%+v\".", tc.dsn, tc.query, err, tc.err)
+		}
+	}
+	db, err := sql.Open(sqldriver.DriverName, "file=../testdata/sample.csv")
+	if err != nil {
+		t.Fatalf("sql.Open() is \"%+v\", want nil.", err)
+	}
+	defer db.Close()
+	if _, err := db.Exec("SELECT * FROM sample"); !errors.Is(err, sqldriver.ErrReadOnly) {
+		t.Errorf("Exec() is \"%+v\", want \"%+v\".", err, sqldriver.ErrReadOnly)
+	}
+	if _, err := db.Begin(); !errors.Is(err, sqldriver.ErrReadOnly) {
+		t.Errorf("Begin() is \"%+v\", want \"%+v\".", err, sqldriver.ErrReadOnly)
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
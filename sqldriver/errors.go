@@ -0,0 +1,27 @@
+package sqldriver
+
+import "errors"
+
+var (
+	ErrInvalidDSN    = errors.New("invalid data source name")
+	ErrSyntax        = errors.New("syntax error in SQL")
+	ErrReadOnly      = errors.New("data source is read-only")
+	ErrUnknownTable  = errors.New("unknown table")
+	ErrUnknownColumn = errors.New("unknown column")
+	ErrArguments     = errors.New("invalid number of arguments")
+)
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
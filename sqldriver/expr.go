@@ -0,0 +1,366 @@
+package sqldriver
+
+import (
+	"database/sql/driver"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/goark/csvdata"
+)
+
+// operand is literal value or placeholder in condition.
+type operand struct {
+	value driver.Value // nil means NULL
+	param int          // ordinal of placeholder (1-based; 0 if literal)
+}
+
+func (o operand) resolve(args []driver.Value) driver.Value {
+	if o.param > 0 {
+		return args[o.param-1]
+	}
+	return o.value
+}
+
+// truth is result of condition in three-valued logic.
+type truth int
+
+const (
+	truthUnknown truth = iota
+	truthFalse
+	truthTrue
+)
+
+func truthOf(b bool) truth {
+	if b {
+		return truthTrue
+	}
+	return truthFalse
+}
+
+// valueFunc is function for getting value of column in current row.
+type valueFunc func(column string) driver.Value
+
+// expr is condition in WHERE clause.
+type expr interface {
+	eval(value valueFunc, args []driver.Value) truth
+	columns() []string
+}
+
+type andExpr struct {
+	left, right expr
+}
+
+func (x *andExpr) eval(value valueFunc, args []driver.Value) truth {
+	l := x.left.eval(value, args)
+	if l == truthFalse {
+		return truthFalse
+	}
+	r := x.right.eval(value, args)
+	if r == truthFalse {
+		return truthFalse
+	}
+	if l == truthUnknown || r == truthUnknown {
+		return truthUnknown
+	}
+	return truthTrue
+}
+
+func (x *andExpr) columns() []string {
+	return append(x.left.columns(), x.right.columns()...)
+}
+
+type orExpr struct {
+	left, right expr
+}
+
+func (x *orExpr) eval(value valueFunc, args []driver.Value) truth {
+	l := x.left.eval(value, args)
+	if l == truthTrue {
+		return truthTrue
+	}
+	r := x.right.eval(value, args)
+	if r == truthTrue {
+		return truthTrue
+	}
+	if l == truthUnknown || r == truthUnknown {
+		return truthUnknown
+	}
+	return truthFalse
+}
+
+func (x *orExpr) columns() []string {
+	return append(x.left.columns(), x.right.columns()...)
+}
+
+type notExpr struct {
+	x expr
+}
+
+func (x *notExpr) eval(value valueFunc, args []driver.Value) truth {
+	switch x.x.eval(value, args) {
+	case truthTrue:
+		return truthFalse
+	case truthFalse:
+		return truthTrue
+	}
+	return truthUnknown
+}
+
+func (x *notExpr) columns() []string {
+	return x.x.columns()
+}
+
+// compareExpr is "column op value" condition.
+type compareExpr struct {
+	column string
+	op     string
+	value  operand
+}
+
+func (x *compareExpr) eval(value valueFunc, args []driver.Value) truth {
+	c, ok := compare(value(x.column), x.value.resolve(args))
+	if !ok {
+		return truthUnknown
+	}
+	switch x.op {
+	case "=":
+		return truthOf(c == 0)
+	case "<>", "!=":
+		return truthOf(c != 0)
+	case "<":
+		return truthOf(c < 0)
+	case "<=":
+		return truthOf(c <= 0)
+	case ">":
+		return truthOf(c > 0)
+	case ">=":
+		return truthOf(c >= 0)
+	}
+	return truthUnknown
+}
+
+func (x *compareExpr) columns() []string {
+	return []string{x.column}
+}
+
+// nullExpr is "column IS [NOT] NULL" condition.
+type nullExpr struct {
+	column string
+	not    bool
+}
+
+func (x *nullExpr) eval(value valueFunc, _ []driver.Value) truth {
+	return truthOf((value(x.column) == nil) != x.not)
+}
+
+func (x *nullExpr) columns() []string {
+	return []string{x.column}
+}
+
+// likeExpr is "column [NOT] LIKE pattern" condition. Wildcards are "%" (any string) and "_" (any character).
+type likeExpr struct {
+	column  string
+	pattern operand
+	not     bool
+}
+
+func (x *likeExpr) eval(value valueFunc, args []driver.Value) truth {
+	v, p := value(x.column), x.pattern.resolve(args)
+	if v == nil || p == nil {
+		return truthUnknown
+	}
+	return truthOf(likeMatch([]rune(toString(v)), []rune(toString(p))) != x.not)
+}
+
+func (x *likeExpr) columns() []string {
+	return []string{x.column}
+}
+
+// inExpr is "column [NOT] IN (value, ...)" condition.
+type inExpr struct {
+	column string
+	values []operand
+	not    bool
+}
+
+func (x *inExpr) eval(value valueFunc, args []driver.Value) truth {
+	v := value(x.column)
+	result := truthFalse
+	for _, o := range x.values {
+		c, ok := compare(v, o.resolve(args))
+		if !ok {
+			result = truthUnknown
+			continue
+		}
+		if c == 0 {
+			result = truthTrue
+			break
+		}
+	}
+	if x.not && result != truthUnknown {
+		return truthOf(result == truthFalse)
+	}
+	return result
+}
+
+func (x *inExpr) columns() []string {
+	return []string{x.column}
+}
+
+// compare function compares a with b. It returns false if a or b is NULL, or these are not comparable.
+// String value is converted into type of another value.
+func compare(a, b driver.Value) (int, bool) {
+	if a == nil || b == nil {
+		return 0, false
+	}
+	if s, ok := b.([]byte); ok {
+		b = string(s)
+	}
+	switch x := a.(type) {
+	case int64:
+		return compareFloat(float64(x), b)
+	case float64:
+		return compareFloat(x, b)
+	case bool:
+		y, ok := toBool(b)
+		if !ok {
+			return 0, false
+		}
+		return compareBool(x, y), true
+	case time.Time:
+		y, ok := toTime(b)
+		if !ok {
+			return 0, false
+		}
+		return x.Compare(y), true
+	case []byte:
+		return compare(string(x), b)
+	case string:
+		if y, ok := b.(string); ok {
+			return strings.Compare(x, y), true
+		}
+		c, ok := compare(b, a)
+		return -c, ok
+	}
+	return 0, false
+}
+
+func compareFloat(x float64, b driver.Value) (int, bool) {
+	var y float64
+	switch v := b.(type) {
+	case int64:
+		y = float64(v)
+	case float64:
+		y = v
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		if err != nil {
+			return 0, false
+		}
+		y = f
+	default:
+		return 0, false
+	}
+	switch {
+	case x < y:
+		return -1, true
+	case x > y:
+		return 1, true
+	}
+	return 0, true
+}
+
+func compareBool(x, y bool) int {
+	switch {
+	case x == y:
+		return 0
+	case y:
+		return -1
+	}
+	return 1
+}
+
+func toBool(v driver.Value) (bool, bool) {
+	switch b := v.(type) {
+	case bool:
+		return b, true
+	case int64:
+		return b != 0, true
+	case string:
+		if x, err := strconv.ParseBool(strings.TrimSpace(b)); err == nil {
+			return x, true
+		}
+	}
+	return false, false
+}
+
+func toTime(v driver.Value) (time.Time, bool) {
+	switch t := v.(type) {
+	case time.Time:
+		return t, true
+	case string:
+		for _, layout := range csvdata.InferTimeLayouts {
+			if tm, err := time.Parse(layout, strings.TrimSpace(t)); err == nil {
+				return tm, true
+			}
+		}
+	}
+	return time.Time{}, false
+}
+
+func toString(v driver.Value) string {
+	switch x := v.(type) {
+	case string:
+		return x
+	case []byte:
+		return string(x)
+	case int64:
+		return strconv.FormatInt(x, 10)
+	case float64:
+		return strconv.FormatFloat(x, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(x)
+	case time.Time:
+		return x.Format(time.RFC3339Nano)
+	}
+	return ""
+}
+
+// likeMatch function reports whether s matches pattern of LIKE operator.
+func likeMatch(s, pattern []rune) bool {
+	si, pi, star, mark := 0, 0, -1, 0
+	for si < len(s) {
+		switch {
+		case pi < len(pattern) && (pattern[pi] == '_' || pattern[pi] == s[si]):
+			si, pi = si+1, pi+1
+		case pi < len(pattern) && pattern[pi] == '%':
+			star, mark = pi, si
+			pi++
+		case star >= 0:
+			mark++
+			si, pi = mark, star+1
+		default:
+			return false
+		}
+	}
+	for pi < len(pattern) && pattern[pi] == '%' {
+		pi++
+	}
+	return pi == len(pattern)
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
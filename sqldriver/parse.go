@@ -0,0 +1,400 @@
+package sqldriver
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/goark/errs"
+)
+
+// query is parsed SELECT statement.
+//
+//	SELECT * | column [[AS] alias], ... FROM table [WHERE condition] [LIMIT n]
+type query struct {
+	columns []selectColumn // empty if "*"
+	table   string
+	where   expr // nil if no WHERE clause
+	limit   int  // -1 if no LIMIT clause
+	params  int  // number of placeholders ("?")
+}
+
+type selectColumn struct {
+	name, alias string
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenQuotedIdent
+	tokenString
+	tokenNumber
+	tokenSymbol
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+// is method reports whether the token is keyword or symbol s (case-insensitive).
+func (t token) is(s string) bool {
+	return (t.kind == tokenIdent || t.kind == tokenSymbol) && strings.EqualFold(t.text, s)
+}
+
+// tokenize function splits SQL statement into tokens.
+func tokenize(s string) ([]token, error) {
+	tokens := []token{}
+	rs := []rune(s)
+	for i := 0; i < len(rs); {
+		r := rs[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '\'' || r == '"' || r == '`':
+			text, n, ok := quoted(rs[i:], r)
+			if !ok {
+				return nil, errs.Wrap(ErrSyntax, errs.WithContext("pos", i), errs.WithContext("reason", "unterminated quote"))
+			}
+			kind := tokenQuotedIdent
+			if r == '\'' {
+				kind = tokenString
+			}
+			tokens = append(tokens, token{kind: kind, text: text, pos: i})
+			i += n
+		case unicode.IsDigit(r) || (r == '.' && i+1 < len(rs) && unicode.IsDigit(rs[i+1])):
+			j := i
+			for j < len(rs) && (unicode.IsDigit(rs[j]) || rs[j] == '.' || rs[j] == 'e' || rs[j] == 'E' || ((rs[j] == '+' || rs[j] == '-') && (rs[j-1] == 'e' || rs[j-1] == 'E'))) {
+				j++
+			}
+			tokens = append(tokens, token{kind: tokenNumber, text: string(rs[i:j]), pos: i})
+			i = j
+		case unicode.IsLetter(r) || r == '_':
+			j := i
+			for j < len(rs) && (unicode.IsLetter(rs[j]) || unicode.IsDigit(rs[j]) || rs[j] == '_') {
+				j++
+			}
+			tokens = append(tokens, token{kind: tokenIdent, text: string(rs[i:j]), pos: i})
+			i = j
+		default:
+			text := string(r)
+			if i+1 < len(rs) {
+				switch two := string(rs[i : i+2]); two {
+				case "<=", ">=", "<>", "!=":
+					text = two
+				}
+			}
+			if !strings.Contains("*,()=<>!?;-", text[:1]) || text == "!" {
+				return nil, errs.Wrap(ErrSyntax, errs.WithContext("pos", i), errs.WithContext("token", text))
+			}
+			tokens = append(tokens, token{kind: tokenSymbol, text: text, pos: i})
+			i += len([]rune(text))
+		}
+	}
+	return append(tokens, token{kind: tokenEOF, pos: len(rs)}), nil
+}
+
+// quoted function returns text in quotes (doubled quote is escaped quote) and length of the quoted token.
+func quoted(rs []rune, q rune) (string, int, bool) {
+	b := strings.Builder{}
+	for i := 1; i < len(rs); i++ {
+		if rs[i] != q {
+			b.WriteRune(rs[i])
+			continue
+		}
+		if i+1 < len(rs) && rs[i+1] == q {
+			b.WriteRune(q)
+			i++
+			continue
+		}
+		return b.String(), i + 1, true
+	}
+	return "", 0, false
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+	params int
+}
+
+// parse function parses SELECT statement.
+func parse(s string) (*query, error) {
+	tokens, err := tokenize(s)
+	if err != nil {
+		return nil, errs.Wrap(err, errs.WithContext("query", s))
+	}
+	p := &parser{tokens: tokens}
+	q, err := p.parseQuery()
+	if err != nil {
+		return nil, errs.Wrap(err, errs.WithContext("query", s))
+	}
+	return q, nil
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+// accept method consumes the next token if it is keyword or symbol s.
+func (p *parser) accept(s string) bool {
+	if p.peek().is(s) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *parser) expect(s string) error {
+	if !p.accept(s) {
+		return p.errorf("expected " + s)
+	}
+	return nil
+}
+
+func (p *parser) errorf(reason string) error {
+	t := p.peek()
+	return errs.Wrap(ErrSyntax, errs.WithContext("pos", t.pos), errs.WithContext("token", t.text), errs.WithContext("reason", reason))
+}
+
+var keywords = map[string]bool{
+	"SELECT": true, "FROM": true, "WHERE": true, "AS": true, "LIMIT": true,
+	"AND": true, "OR": true, "NOT": true, "IS": true, "NULL": true, "LIKE": true, "IN": true, "TRUE": true, "FALSE": true,
+}
+
+func (p *parser) ident() (string, error) {
+	t := p.peek()
+	switch {
+	case t.kind == tokenQuotedIdent:
+	case t.kind == tokenIdent && !keywords[strings.ToUpper(t.text)]:
+	default:
+		return "", p.errorf("expected identifier")
+	}
+	p.pos++
+	return t.text, nil
+}
+
+func (p *parser) parseQuery() (*query, error) {
+	q := &query{limit: -1}
+	if err := p.expect("SELECT"); err != nil {
+		return nil, err
+	}
+	if !p.accept("*") {
+		for {
+			name, err := p.ident()
+			if err != nil {
+				return nil, err
+			}
+			col := selectColumn{name: name, alias: name}
+			if p.accept("AS") || p.peek().kind == tokenIdent && !keywords[strings.ToUpper(p.peek().text)] || p.peek().kind == tokenQuotedIdent {
+				if col.alias, err = p.ident(); err != nil {
+					return nil, err
+				}
+			}
+			q.columns = append(q.columns, col)
+			if !p.accept(",") {
+				break
+			}
+		}
+	}
+	if err := p.expect("FROM"); err != nil {
+		return nil, err
+	}
+	table, err := p.ident()
+	if err != nil {
+		return nil, err
+	}
+	q.table = table
+	if p.accept("WHERE") {
+		if q.where, err = p.parseOr(); err != nil {
+			return nil, err
+		}
+	}
+	if p.accept("LIMIT") {
+		n, err := strconv.Atoi(p.peek().text)
+		if p.peek().kind != tokenNumber || err != nil {
+			return nil, p.errorf("expected number of LIMIT clause")
+		}
+		p.pos++
+		q.limit = n
+	}
+	p.accept(";")
+	if p.peek().kind != tokenEOF {
+		return nil, p.errorf("unexpected token")
+	}
+	q.params = p.params
+	return q, nil
+}
+
+func (p *parser) parseOr() (expr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.accept("OR") {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &orExpr{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseAnd() (expr, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for p.accept("AND") {
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		left = &andExpr{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseNot() (expr, error) {
+	if p.accept("NOT") {
+		x, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return &notExpr{x: x}, nil
+	}
+	if p.accept("(") {
+		x, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+		return x, nil
+	}
+	return p.parsePredicate()
+}
+
+func (p *parser) parsePredicate() (expr, error) {
+	column, err := p.ident()
+	if err != nil {
+		return nil, err
+	}
+	if p.accept("IS") {
+		not := p.accept("NOT")
+		if err := p.expect("NULL"); err != nil {
+			return nil, err
+		}
+		return &nullExpr{column: column, not: not}, nil
+	}
+	not := p.accept("NOT")
+	switch {
+	case p.accept("LIKE"):
+		pattern, err := p.parseOperand()
+		if err != nil {
+			return nil, err
+		}
+		return &likeExpr{column: column, pattern: pattern, not: not}, nil
+	case p.accept("IN"):
+		if err := p.expect("("); err != nil {
+			return nil, err
+		}
+		x := &inExpr{column: column, not: not}
+		for {
+			v, err := p.parseOperand()
+			if err != nil {
+				return nil, err
+			}
+			x.values = append(x.values, v)
+			if !p.accept(",") {
+				break
+			}
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+		return x, nil
+	case not:
+		return nil, p.errorf("expected LIKE or IN")
+	}
+	t := p.peek()
+	switch {
+	case t.kind != tokenSymbol:
+		return nil, p.errorf("expected comparison operator")
+	case t.text == "=", t.text == "<>", t.text == "!=", t.text == "<", t.text == "<=", t.text == ">", t.text == ">=":
+		p.pos++
+	default:
+		return nil, p.errorf("expected comparison operator")
+	}
+	value, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	return &compareExpr{column: column, op: t.text, value: value}, nil
+}
+
+func (p *parser) parseOperand() (operand, error) {
+	if p.accept("-") {
+		t := p.peek()
+		if t.kind != tokenNumber {
+			return operand{}, p.errorf("expected number")
+		}
+		v, err := p.parseOperand()
+		if err != nil {
+			return operand{}, err
+		}
+		switch n := v.value.(type) {
+		case int64:
+			v.value = -n
+		case float64:
+			v.value = -n
+		}
+		return v, nil
+	}
+	t := p.peek()
+	switch {
+	case t.kind == tokenNumber:
+		if n, err := strconv.ParseInt(t.text, 10, 64); err == nil {
+			p.pos++
+			return operand{value: n}, nil
+		}
+		if f, err := strconv.ParseFloat(t.text, 64); err == nil {
+			p.pos++
+			return operand{value: f}, nil
+		}
+	case t.kind == tokenString:
+		p.pos++
+		return operand{value: t.text}, nil
+	case t.is("?"):
+		p.pos++
+		p.params++
+		return operand{param: p.params}, nil
+	case t.is("TRUE"), t.is("FALSE"):
+		p.pos++
+		return operand{value: t.is("TRUE")}, nil
+	case t.is("NULL"):
+		p.pos++
+		return operand{}, nil
+	}
+	return operand{}, p.errorf("expected literal or placeholder")
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,202 @@
+package sqldriver
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"reflect"
+	"strings"
+
+	"github.com/goark/csvdata"
+	"github.com/goark/errs"
+)
+
+// column is column in table.
+type column struct {
+	name   string
+	typ    csvdata.ColumnType
+	layout string
+}
+
+var (
+	scanTypes = map[csvdata.ColumnType]reflect.Type{
+		csvdata.TypeString: reflect.TypeOf(sql.NullString{}),
+		csvdata.TypeBool:   reflect.TypeOf(sql.NullBool{}),
+		csvdata.TypeInt:    reflect.TypeOf(sql.NullInt64{}),
+		csvdata.TypeFloat:  reflect.TypeOf(sql.NullFloat64{}),
+		csvdata.TypeTime:   reflect.TypeOf(sql.NullTime{}),
+	}
+	databaseTypeNames = map[csvdata.ColumnType]string{
+		csvdata.TypeString: "TEXT",
+		csvdata.TypeBool:   "BOOLEAN",
+		csvdata.TypeInt:    "INTEGER",
+		csvdata.TypeFloat:  "REAL",
+		csvdata.TypeTime:   "DATETIME",
+	}
+)
+
+// rows is result set of query.
+type rows struct {
+	rc      *csvdata.Rows
+	table   []column
+	exact   map[string]int
+	folded  map[string]int
+	output  []int    // indexes of selected columns in table
+	names   []string // names of selected columns
+	where   expr
+	args    []driver.Value
+	limit   int
+	count   int
+	pending bool // current row of rc is not returned yet
+}
+
+var (
+	_ driver.Rows                           = (*rows)(nil) //rows is compatible with driver.Rows interface
+	_ driver.RowsColumnTypeScanType         = (*rows)(nil) //rows is compatible with driver.RowsColumnTypeScanType interface
+	_ driver.RowsColumnTypeDatabaseTypeName = (*rows)(nil) //rows is compatible with driver.RowsColumnTypeDatabaseTypeName interface
+	_ driver.RowsColumnTypeNullable         = (*rows)(nil) //rows is compatible with driver.RowsColumnTypeNullable interface
+)
+
+func newRows(rc *csvdata.Rows, table []column, q *query, args []driver.Value) (*rows, error) {
+	r := &rows{rc: rc, table: table, exact: map[string]int{}, folded: map[string]int{}, where: q.where, args: args, limit: q.limit}
+	for i, c := range table {
+		if _, ok := r.exact[c.name]; !ok {
+			r.exact[c.name] = i
+		}
+		if _, ok := r.folded[strings.ToLower(c.name)]; !ok {
+			r.folded[strings.ToLower(c.name)] = i
+		}
+	}
+	if len(q.columns) == 0 {
+		for i, c := range table {
+			r.output, r.names = append(r.output, i), append(r.names, c.name)
+		}
+	}
+	for _, c := range q.columns {
+		i, ok := r.lookup(c.name)
+		if !ok {
+			return nil, errs.Wrap(ErrUnknownColumn, errs.WithContext("column", c.name))
+		}
+		r.output, r.names = append(r.output, i), append(r.names, c.alias)
+	}
+	if q.where != nil {
+		for _, name := range q.where.columns() {
+			if _, ok := r.lookup(name); !ok {
+				return nil, errs.Wrap(ErrUnknownColumn, errs.WithContext("column", name))
+			}
+		}
+	}
+	return r, nil
+}
+
+// lookup method returns index of column by name (case-insensitive if not found).
+func (r *rows) lookup(name string) (int, bool) {
+	if i, ok := r.exact[name]; ok {
+		return i, true
+	}
+	i, ok := r.folded[strings.ToLower(name)]
+	return i, ok
+}
+
+// Columns method returns names of selected columns.
+// This method is a implementation of driver.Rows interface.
+func (r *rows) Columns() []string {
+	return r.names
+}
+
+// Close method closes source of rows.
+// This method is a implementation of driver.Rows interface.
+func (r *rows) Close() error {
+	return errs.Wrap(r.rc.Close())
+}
+
+// Next method sets values of the next row matching condition into dest.
+// This method is a implementation of driver.Rows interface.
+func (r *rows) Next(dest []driver.Value) error {
+	for r.limit < 0 || r.count < r.limit {
+		if r.pending {
+			r.pending = false
+		} else if err := r.rc.Next(); err != nil {
+			if errs.Is(err, io.EOF) {
+				return io.EOF // database/sql requires io.EOF as is
+			}
+			return errs.Wrap(err)
+		}
+		if r.where != nil && r.where.eval(r.valueOf, r.args) != truthTrue {
+			continue
+		}
+		for j, i := range r.output {
+			dest[j] = r.value(i)
+		}
+		r.count++
+		return nil
+	}
+	return io.EOF
+}
+
+func (r *rows) valueOf(name string) driver.Value {
+	i, _ := r.lookup(name)
+	return r.value(i)
+}
+
+// value method returns value of i-th column in current row by type of the column. Empty value is NULL.
+// If value can not be parsed as the type, string value is returned.
+func (r *rows) value(i int) driver.Value {
+	s, err := r.rc.GetString(i)
+	if err != nil || len(strings.TrimSpace(s)) == 0 {
+		return nil
+	}
+	c := r.table[i]
+	switch c.typ {
+	case csvdata.TypeInt:
+		if n, err := r.rc.GetInt64(i, 10); err == nil {
+			return n
+		}
+	case csvdata.TypeFloat:
+		if f, err := r.rc.GetFloat64(i); err == nil {
+			return f
+		}
+	case csvdata.TypeBool:
+		if b, err := r.rc.GetBool(i); err == nil {
+			return b
+		}
+	case csvdata.TypeTime:
+		if tm, err := r.rc.GetTime(i, c.layout); err == nil {
+			return tm
+		}
+	}
+	return s
+}
+
+// ColumnTypeScanType method returns sql.NullXxx type of the column.
+// This method is a implementation of driver.RowsColumnTypeScanType interface.
+func (r *rows) ColumnTypeScanType(index int) reflect.Type {
+	return scanTypes[r.table[r.output[index]].typ]
+}
+
+// ColumnTypeDatabaseTypeName method returns name of column type (TEXT, BOOLEAN, INTEGER, REAL or DATETIME).
+// This method is a implementation of driver.RowsColumnTypeDatabaseTypeName interface.
+func (r *rows) ColumnTypeDatabaseTypeName(index int) string {
+	return databaseTypeNames[r.table[r.output[index]].typ]
+}
+
+// ColumnTypeNullable method returns true, because empty value is NULL.
+// This method is a implementation of driver.RowsColumnTypeNullable interface.
+func (r *rows) ColumnTypeNullable(int) (bool, bool) {
+	return true, true
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,202 @@
+package sqlload
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/goark/csvdata"
+	"github.com/goark/errs"
+)
+
+// Conflict is policy for rows conflicting with existing rows (unique constraint).
+type Conflict int
+
+const (
+	ConflictError   Conflict = iota // abort loading (default)
+	ConflictIgnore                  // skip conflicting rows
+	ConflictReplace                 // replace existing rows (update non-key columns if key columns are set)
+)
+
+// Dialect is interface for SQL dialect of database.
+type Dialect interface {
+	Quote(name string) string
+	Placeholder(n int) string // n is 1-based
+	TypeName(t csvdata.ColumnType) string
+	Insert(table string, columns, key []string, conflict Conflict) (string, error)
+}
+
+// keyTypeNamer is optional interface for Dialect to return type name of key column, if it differs from TypeName method.
+type keyTypeNamer interface {
+	KeyTypeName(t csvdata.ColumnType) string
+}
+
+var (
+	SQLite     Dialect = sqlite{}     // SQLite (default)
+	PostgreSQL Dialect = postgreSQL{} // PostgreSQL
+	MySQL      Dialect = mySQL{}      // MySQL and MariaDB
+)
+
+func quote(name, q string) string {
+	return q + strings.ReplaceAll(name, q, q+q) + q
+}
+
+func insert(d Dialect, verb, table string, columns []string) string {
+	names := make([]string, len(columns))
+	params := make([]string, len(columns))
+	for i, c := range columns {
+		names[i], params[i] = d.Quote(c), d.Placeholder(i+1)
+	}
+	return verb + " " + d.Quote(table) + " (" + strings.Join(names, ", ") + ") VALUES (" + strings.Join(params, ", ") + ")"
+}
+
+// updates function returns assignments of non-key columns from inserted values.
+func updates(d Dialect, columns, key []string, value func(string) string) []string {
+	sets := []string{}
+	for _, c := range columns {
+		if !slices.Contains(key, c) {
+			sets = append(sets, d.Quote(c)+" = "+value(d.Quote(c)))
+		}
+	}
+	return sets
+}
+
+// upsert function returns INSERT statement with ON CONFLICT clause on key columns (SQLite and PostgreSQL).
+func upsert(d Dialect, table string, columns, key []string) string {
+	stmt := insert(d, "INSERT INTO", table, columns)
+	keys := make([]string, len(key))
+	for i, k := range key {
+		keys[i] = d.Quote(k)
+	}
+	sets := updates(d, columns, key, func(c string) string { return "EXCLUDED." + c })
+	if len(sets) == 0 {
+		return stmt + " ON CONFLICT (" + strings.Join(keys, ", ") + ") DO NOTHING"
+	}
+	return stmt + " ON CONFLICT (" + strings.Join(keys, ", ") + ") DO UPDATE SET " + strings.Join(sets, ", ")
+}
+
+type sqlite struct{}
+
+func (sqlite) Quote(name string) string { return quote(name, `"`) }
+func (sqlite) Placeholder(int) string   { return "?" }
+func (sqlite) TypeName(t csvdata.ColumnType) string {
+	switch t {
+	case csvdata.TypeInt:
+		return "INTEGER"
+	case csvdata.TypeFloat:
+		return "REAL"
+	case csvdata.TypeBool:
+		return "BOOLEAN"
+	case csvdata.TypeTime:
+		return "DATETIME"
+	}
+	return "TEXT"
+}
+
+// Insert method returns INSERT statement. ConflictReplace updates non-key columns on conflict of key columns,
+// or replaces rows conflicting with any primary key or unique constraint if key columns are not set.
+func (d sqlite) Insert(table string, columns, key []string, conflict Conflict) (string, error) {
+	switch conflict {
+	case ConflictIgnore:
+		return insert(d, "INSERT OR IGNORE INTO", table, columns), nil
+	case ConflictReplace:
+		if len(key) > 0 {
+			return upsert(d, table, columns, key), nil
+		}
+		return insert(d, "INSERT OR REPLACE INTO", table, columns), nil
+	}
+	return insert(d, "INSERT INTO", table, columns), nil
+}
+
+type postgreSQL struct{}
+
+func (postgreSQL) Quote(name string) string { return quote(name, `"`) }
+func (postgreSQL) Placeholder(n int) string { return "$" + strconv.Itoa(n) }
+func (postgreSQL) TypeName(t csvdata.ColumnType) string {
+	switch t {
+	case csvdata.TypeInt:
+		return "BIGINT"
+	case csvdata.TypeFloat:
+		return "DOUBLE PRECISION"
+	case csvdata.TypeBool:
+		return "BOOLEAN"
+	case csvdata.TypeTime:
+		return "TIMESTAMP WITH TIME ZONE"
+	}
+	return "TEXT"
+}
+
+// Insert method returns INSERT statement. ConflictReplace updates non-key columns on conflict of key columns (key columns are required).
+func (d postgreSQL) Insert(table string, columns, key []string, conflict Conflict) (string, error) {
+	switch conflict {
+	case ConflictIgnore:
+		return insert(d, "INSERT INTO", table, columns) + " ON CONFLICT DO NOTHING", nil
+	case ConflictReplace:
+		if len(key) == 0 {
+			return "", errs.Wrap(ErrNoKey, errs.WithContext("table", table))
+		}
+		return upsert(d, table, columns, key), nil
+	}
+	return insert(d, "INSERT INTO", table, columns), nil
+}
+
+type mySQL struct{}
+
+func (mySQL) Quote(name string) string { return quote(name, "`") }
+func (mySQL) Placeholder(int) string   { return "?" }
+func (mySQL) TypeName(t csvdata.ColumnType) string {
+	switch t {
+	case csvdata.TypeInt:
+		return "BIGINT"
+	case csvdata.TypeFloat:
+		return "DOUBLE"
+	case csvdata.TypeBool:
+		return "BOOLEAN"
+	case csvdata.TypeTime:
+		return "DATETIME"
+	}
+	return "TEXT"
+}
+
+// KeyTypeName method returns type name of key column. MySQL can not make key of TEXT column without prefix length.
+func (d mySQL) KeyTypeName(t csvdata.ColumnType) string {
+	if t == csvdata.TypeString {
+		return "VARCHAR(255)"
+	}
+	return d.TypeName(t)
+}
+
+// Insert method returns INSERT statement. MySQL can not specify conflict target, so that ConflictReplace applies to
+// any primary key or unique constraint of the table: it updates non-key columns if key columns are set,
+// or replaces (deletes and inserts) conflicting rows otherwise.
+func (d mySQL) Insert(table string, columns, key []string, conflict Conflict) (string, error) {
+	switch conflict {
+	case ConflictIgnore:
+		return insert(d, "INSERT IGNORE INTO", table, columns), nil
+	case ConflictReplace:
+		if len(key) > 0 {
+			sets := updates(d, columns, key, func(c string) string { return "VALUES(" + c + ")" })
+			if len(sets) == 0 {
+				return insert(d, "INSERT IGNORE INTO", table, columns), nil
+			}
+			return insert(d, "INSERT INTO", table, columns) + " ON DUPLICATE KEY UPDATE " + strings.Join(sets, ", "), nil
+		}
+		return insert(d, "REPLACE INTO", table, columns), nil
+	}
+	return insert(d, "INSERT INTO", table, columns), nil
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
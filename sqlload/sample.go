@@ -0,0 +1,101 @@
+package sqlload
+
+import (
+	"io"
+
+	"github.com/goark/csvdata"
+	"github.com/goark/errs"
+)
+
+// sample is rows read ahead from csvdata.Rows for inferring column types.
+// It replays header and rows with typed values of cells as csvdata.RowsReader.
+type sample struct {
+	header     []string
+	records    [][]string
+	cells      [][]csvdata.Cell
+	typed      [][]bool
+	trimSpace  bool
+	lazyQuotes bool
+	dateSystem csvdata.DateSystem
+}
+
+// readSample function reads header and up to n rows from rc.
+func readSample(rc *csvdata.Rows, n int) (*sample, error) {
+	header, err := rc.Header()
+	if err != nil && !errs.Is(err, io.EOF) {
+		return nil, errs.Wrap(err)
+	}
+	s := &sample{header: header, trimSpace: rc.TrimSpace(), lazyQuotes: rc.LazyQuotes(), dateSystem: rc.DateSystem()}
+	for len(s.records) < n {
+		if err := rc.Next(); err != nil {
+			if errs.Is(err, io.EOF) {
+				break
+			}
+			return nil, errs.Wrap(err)
+		}
+		record := append([]string{}, rc.Row()...)
+		cells, typed := make([]csvdata.Cell, len(record)), make([]bool, len(record))
+		for i := range record {
+			cells[i], typed[i] = rc.Cell(i)
+		}
+		s.records, s.cells, s.typed = append(s.records, record), append(s.cells, cells), append(s.typed, typed)
+	}
+	return s, nil
+}
+
+// reader method returns csvdata.RowsReader instance which replays header and rows.
+func (s *sample) reader() *sampleReader {
+	return &sampleReader{sample: s, row: -1}
+}
+
+// sampleReader is csvdata.RowsReader for replaying sample.
+type sampleReader struct {
+	*sample
+	row int // index of current record (-1 for header)
+}
+
+var (
+	_ csvdata.RowsReader       = (*sampleReader)(nil) //sampleReader is compatible with csvdata.RowsReader interface
+	_ csvdata.TypedReader      = (*sampleReader)(nil) //sampleReader is compatible with csvdata.TypedReader interface
+	_ csvdata.DateSystemReader = (*sampleReader)(nil) //sampleReader is compatible with csvdata.DateSystemReader interface
+)
+
+func (r *sampleReader) Read() ([]string, error) {
+	if r.row < 0 {
+		r.row = 0
+		return r.header, nil
+	}
+	if r.row >= len(r.records) {
+		return nil, errs.Wrap(io.EOF)
+	}
+	r.row++
+	return r.records[r.row-1], nil
+}
+
+func (r *sampleReader) Cell(field int) (csvdata.Cell, bool) {
+	i := r.row - 1
+	if i < 0 || i >= len(r.cells) || field < 0 || field >= len(r.cells[i]) {
+		return csvdata.Cell{}, false
+	}
+	return r.cells[i][field], r.typed[i][field]
+}
+
+func (r *sampleReader) DateSystem() csvdata.DateSystem { return r.dateSystem }
+func (r *sampleReader) TrimSpace() bool                { return r.trimSpace }
+func (r *sampleReader) LazyQuotes() bool               { return r.lazyQuotes }
+func (r *sampleReader) Close() error                   { return nil }
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
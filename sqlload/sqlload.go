@@ -0,0 +1,300 @@
+// Package sqlload loads rows of csvdata.Rows into a table of database/sql.
+package sqlload
+
+import (
+	"context"
+	"database/sql"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/goark/csvdata"
+	"github.com/goark/errs"
+)
+
+const (
+	DefaultBatchSize = 1000 // number of rows in a transaction
+	DefaultInferRows = 100  // number of rows for inferring column types
+)
+
+// Column is mapping from column in Rows to column in table.
+type Column struct {
+	Source string // column name in header of Rows
+	Name   string // column name in table (the same as Source if empty)
+}
+
+// Loader is class of bulk loader into a table.
+type Loader struct {
+	db        *sql.DB
+	table     string
+	dialect   Dialect
+	columns   []Column
+	key       []string
+	batchSize int
+	inferRows int
+	conflict  Conflict
+}
+
+// New function creates a new Loader instance for table in db.
+func New(db *sql.DB, table string) *Loader {
+	return &Loader{db: db, table: table, dialect: SQLite, batchSize: DefaultBatchSize, inferRows: DefaultInferRows}
+}
+
+// WithDialect method sets SQL dialect of database (SQLite by default).
+func (l *Loader) WithDialect(d Dialect) *Loader {
+	if l == nil {
+		return nil
+	}
+	if d != nil {
+		l.dialect = d
+	}
+	return l
+}
+
+// WithColumns method sets column mapping. All columns in header are loaded with the same names by default.
+func (l *Loader) WithColumns(cols ...Column) *Loader {
+	if l == nil {
+		return nil
+	}
+	l.columns = cols
+	return l
+}
+
+// WithKey method sets names of key columns in table. These are primary key of created table, and conflict target for ConflictReplace
+// (non-key columns are updated). MySQL applies ConflictReplace to any primary key or unique constraint of the table.
+func (l *Loader) WithKey(names ...string) *Loader {
+	if l == nil {
+		return nil
+	}
+	l.key = names
+	return l
+}
+
+// WithBatchSize method sets number of rows inserted in a transaction.
+func (l *Loader) WithBatchSize(n int) *Loader {
+	if l == nil {
+		return nil
+	}
+	if n > 0 {
+		l.batchSize = n
+	}
+	return l
+}
+
+// WithInferRows method sets number of rows for inferring column types of created table.
+func (l *Loader) WithInferRows(n int) *Loader {
+	if l == nil {
+		return nil
+	}
+	if n > 0 {
+		l.inferRows = n
+	}
+	return l
+}
+
+// WithConflict method sets policy for rows conflicting with existing rows.
+func (l *Loader) WithConflict(c Conflict) *Loader {
+	if l == nil {
+		return nil
+	}
+	l.conflict = c
+	return l
+}
+
+// Load method creates table from inferred column types if absent, and inserts all rows of rc in batched transactions.
+// rc must have header. It returns number of inserted (or replaced) rows. Committed batches remain if error occurs.
+func (l *Loader) Load(ctx context.Context, rc *csvdata.Rows) (int64, error) {
+	if l == nil || l.db == nil || rc == nil {
+		return 0, errs.Wrap(csvdata.ErrNullPointer)
+	}
+	s, err := readSample(rc, l.inferRows)
+	if err != nil {
+		return 0, errs.Wrap(err, errs.WithContext("table", l.table))
+	}
+	profile, err := csvdata.Infer(s.reader(), 0)
+	if err != nil && !errs.Is(err, io.EOF) {
+		return 0, errs.Wrap(err, errs.WithContext("table", l.table))
+	}
+	cols, schemas, index, err := l.mapping(rc, profile)
+	if err != nil {
+		return 0, errs.Wrap(err, errs.WithContext("table", l.table))
+	}
+	if err := l.create(ctx, cols, schemas); err != nil {
+		return 0, errs.Wrap(err, errs.WithContext("table", l.table))
+	}
+	query, err := l.dialect.Insert(l.table, cols, l.key, l.conflict)
+	if err != nil {
+		return 0, errs.Wrap(err)
+	}
+	stmt, err := l.db.PrepareContext(ctx, query) // prepared once and shared by batches
+	if err != nil {
+		return 0, errs.Wrap(err, errs.WithContext("table", l.table), errs.WithContext("query", query))
+	}
+	defer stmt.Close()
+
+	sampleRows, sampled := csvdata.NewRows(s.reader(), true), false
+	next := func() (*csvdata.Rows, error) {
+		if !sampled {
+			if err := sampleRows.Next(); err == nil {
+				return sampleRows, nil
+			}
+			sampled = true
+		}
+		if err := rc.Next(); err != nil {
+			return nil, err
+		}
+		return rc, nil
+	}
+	var total int64
+	for {
+		n, err := l.insertBatch(ctx, stmt, schemas, index, next)
+		total += n
+		if err != nil {
+			if errs.Is(err, io.EOF) {
+				return total, nil
+			}
+			return total, errs.Wrap(err, errs.WithContext("table", l.table))
+		}
+	}
+}
+
+// mapping method returns names, schemas and indexes in header of loaded columns.
+func (l *Loader) mapping(rc *csvdata.Rows, profile *csvdata.Profile) ([]string, []csvdata.ColumnSchema, []int, error) {
+	mapping := l.columns
+	if len(mapping) == 0 && profile != nil {
+		for _, c := range profile.Columns {
+			if len(c.Name) > 0 {
+				mapping = append(mapping, Column{Source: c.Name})
+			}
+		}
+	}
+	if len(mapping) == 0 {
+		return nil, nil, nil, errs.Wrap(ErrNoColumn)
+	}
+	cols, schemas, index := make([]string, len(mapping)), make([]csvdata.ColumnSchema, len(mapping)), make([]int, len(mapping))
+	for i, m := range mapping {
+		j, err := rc.ColumnIndex(m.Source)
+		if err != nil {
+			return nil, nil, nil, errs.Wrap(err)
+		}
+		cols[i], index[i] = m.Name, j
+		if len(cols[i]) == 0 {
+			cols[i] = strings.TrimSpace(m.Source)
+		}
+		if profile != nil && j < len(profile.Columns) {
+			schemas[i] = profile.Columns[j].ColumnSchema()
+		}
+		schemas[i].Name = cols[i]
+	}
+	return cols, schemas, index, nil
+}
+
+// create method creates table if not exists.
+func (l *Loader) create(ctx context.Context, cols []string, schemas []csvdata.ColumnSchema) error {
+	defs := make([]string, len(cols))
+	for i, c := range cols {
+		typeName := l.dialect.TypeName(schemas[i].Type)
+		if kt, ok := l.dialect.(keyTypeNamer); ok && slices.Contains(l.key, c) {
+			typeName = kt.KeyTypeName(schemas[i].Type)
+		}
+		defs[i] = l.dialect.Quote(c) + " " + typeName
+	}
+	if len(l.key) > 0 {
+		keys := make([]string, len(l.key))
+		for i, k := range l.key {
+			keys[i] = l.dialect.Quote(k)
+		}
+		defs = append(defs, "PRIMARY KEY ("+strings.Join(keys, ", ")+")")
+	}
+	query := "CREATE TABLE IF NOT EXISTS " + l.dialect.Quote(l.table) + " (" + strings.Join(defs, ", ") + ")"
+	if _, err := l.db.ExecContext(ctx, query); err != nil {
+		return errs.Wrap(err, errs.WithContext("query", query))
+	}
+	return nil
+}
+
+// insertBatch method inserts up to batch size rows in a transaction. It returns io.EOF error at the end of rows.
+func (l *Loader) insertBatch(ctx context.Context, prepared *sql.Stmt, schemas []csvdata.ColumnSchema, index []int, next func() (*csvdata.Rows, error)) (int64, error) {
+	tx, err := l.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, errs.Wrap(err)
+	}
+	stmt := tx.StmtContext(ctx, prepared) // reuses prepared statement on the connection of transaction
+	defer stmt.Close()
+	var count int64
+	var eof error
+	args := make([]any, len(index))
+	for i := 0; i < l.batchSize; i++ {
+		rows, err := next()
+		if err != nil {
+			if errs.Is(err, io.EOF) {
+				eof = err
+				break
+			}
+			_ = tx.Rollback()
+			return 0, errs.Wrap(err)
+		}
+		for j, k := range index {
+			args[j] = value(rows, k, schemas[j])
+		}
+		res, err := stmt.ExecContext(ctx, args...)
+		if err != nil {
+			_ = tx.Rollback()
+			return 0, errs.Wrap(err)
+		}
+		if n, err := res.RowsAffected(); err == nil && n > 0 {
+			count++
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, errs.Wrap(err)
+	}
+	return count, eof
+}
+
+// value function returns value of i-th field in current row by type of column: int64, float64, bool, time.Time or string.
+// Empty value is NULL. If value can not be parsed as the type, string value is returned.
+func value(rows *csvdata.Rows, i int, c csvdata.ColumnSchema) any {
+	s, err := rows.GetString(i)
+	if err != nil || len(strings.TrimSpace(s)) == 0 {
+		return nil
+	}
+	switch c.Type {
+	case csvdata.TypeInt:
+		base := c.Base
+		if base == 0 {
+			base = 10
+		}
+		if n, err := rows.GetInt64(i, base); err == nil {
+			return n
+		}
+	case csvdata.TypeFloat:
+		if f, err := rows.GetFloat64(i); err == nil {
+			return f
+		}
+	case csvdata.TypeBool:
+		if b, err := rows.GetBool(i); err == nil {
+			return b
+		}
+	case csvdata.TypeTime:
+		if tm, err := rows.GetTime(i, c.Layout); err == nil {
+			return tm
+		}
+	}
+	return s
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,176 @@
+package sqlload_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/goark/csvdata"
+	"github.com/goark/csvdata/sqlload"
+	_ "modernc.org/sqlite"
+)
+
+func openDB(t *testing.T) *sql.DB {
+	t.Helper()
+	db, err := sql.Open("sqlite", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	db.SetMaxOpenConns(1) // in-memory database is per connection
+	t.Cleanup(func() { _ = db.Close() })
+	return db
+}
+
+func load(t *testing.T, l *sqlload.Loader) (int64, error) {
+	t.Helper()
+	file, err := csvdata.OpenFile("../testdata/sample.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rc := csvdata.NewRows(csvdata.New(file).WithTrimSpace(true), true)
+	defer rc.Close()
+	return l.Load(context.Background(), rc)
+}
+
+func TestLoad(t *testing.T) {
+	db := openDB(t)
+	n, err := load(t, sqlload.New(db, "planets").WithBatchSize(3).WithInferRows(2))
+	if err != nil {
+		t.Fatalf("Load() is \"%+v\", want nil.", err)
+	}
+	if n != 4 {
+		t.Errorf("Load() is %v, want %v.", n, 4)
+	}
+	rows, err := db.Query(`SELECT "order", name, mass, typeof(mass), habitable FROM planets ORDER BY "order"`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+	got := []string{}
+	for rows.Next() {
+		var order int64
+		var name, typ string
+		var mass float64
+		var habitable bool
+		if err := rows.Scan(&order, &name, &mass, &typ, &habitable); err != nil {
+			t.Fatal(err)
+		}
+		if order == 3 && (mass != 1 || !habitable) {
+			t.Errorf("row of Earth is %v, %v.", mass, habitable)
+		}
+		got = append(got, name+":"+typ)
+	}
+	want := []string{"Mercury:real", "Venus:real", "Earth:real", "Mars:real"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("loaded rows are %q, want %q.", got, want)
+	}
+}
+
+func TestLoadMapping(t *testing.T) {
+	db := openDB(t)
+	l := sqlload.New(db, "planets").WithColumns(sqlload.Column{Source: "name", Name: "planet"}, sqlload.Column{Source: "distance", Name: "au"})
+	if _, err := load(t, l); err != nil {
+		t.Fatalf("Load() is \"%+v\", want nil.", err)
+	}
+	var planet string
+	var au float64
+	if err := db.QueryRow(`SELECT planet, au FROM planets WHERE au > 1`).Scan(&planet, &au); err != nil {
+		t.Fatal(err)
+	}
+	if planet != "Mars" || au != 1.5 {
+		t.Errorf("loaded row is %v, %v, want %v, %v.", planet, au, "Mars", 1.5)
+	}
+	if _, err := load(t, sqlload.New(db, "planets2").WithColumns(sqlload.Column{Source: "radius"})); err == nil {
+		t.Error("Load() is nil, want error.")
+	}
+}
+
+func TestLoadConflict(t *testing.T) {
+	db := openDB(t)
+	testCases := []struct {
+		conflict sqlload.Conflict
+		n        int64
+		err      bool
+	}{
+		{conflict: sqlload.ConflictError, n: 4},
+		{conflict: sqlload.ConflictIgnore, n: 0},
+		{conflict: sqlload.ConflictReplace, n: 4},
+		{conflict: sqlload.ConflictError, n: 0, err: true},
+	}
+	for _, tc := range testCases {
+		n, err := load(t, sqlload.New(db, "planets").WithKey("order").WithConflict(tc.conflict))
+		if (err != nil) != tc.err {
+			t.Errorf("Load(%v) is \"%+v\", want error %v.", tc.conflict, err, tc.err)
+		}
+		if n != tc.n {
+			t.Errorf("Load(%v) is %v, want %v.", tc.conflict, n, tc.n)
+		}
+	}
+	var count int
+	if err := db.QueryRow(`SELECT count(*) FROM planets`).Scan(&count); err != nil || count != 4 {
+		t.Errorf("count of rows is %v, \"%+v\", want %v.", count, err, 4)
+	}
+}
+
+func TestLoadKeyType(t *testing.T) {
+	db := openDB(t) // SQLite accepts CREATE TABLE statement of MySQL dialect
+	if _, err := load(t, sqlload.New(db, "planets").WithDialect(sqlload.MySQL).WithKey("name")); err != nil {
+		t.Fatalf("Load() is \"%+v\", want nil.", err)
+	}
+	var query string
+	if err := db.QueryRow(`SELECT sql FROM sqlite_master WHERE name = 'planets'`).Scan(&query); err != nil {
+		t.Fatal(err)
+	}
+	if want := "`name` VARCHAR(255)"; !strings.Contains(query, want) {
+		t.Errorf("CREATE TABLE is %q, want %q.", query, want)
+	}
+}
+
+func TestDialect(t *testing.T) {
+	testCases := []struct {
+		dialect  sqlload.Dialect
+		key      []string
+		conflict sqlload.Conflict
+		want     string
+	}{
+		{dialect: sqlload.SQLite, key: []string{"order"}, conflict: sqlload.ConflictIgnore, want: `INSERT OR IGNORE INTO "planets" ("order", "name") VALUES (?, ?)`},
+		{dialect: sqlload.SQLite, key: []string{"order"}, conflict: sqlload.ConflictReplace, want: `INSERT INTO "planets" ("order", "name") VALUES (?, ?) ON CONFLICT ("order") DO UPDATE SET "name" = EXCLUDED."name"`},
+		{dialect: sqlload.SQLite, conflict: sqlload.ConflictReplace, want: `INSERT OR REPLACE INTO "planets" ("order", "name") VALUES (?, ?)`},
+		{dialect: sqlload.PostgreSQL, key: []string{"order"}, conflict: sqlload.ConflictIgnore, want: `INSERT INTO "planets" ("order", "name") VALUES ($1, $2) ON CONFLICT DO NOTHING`},
+		{dialect: sqlload.PostgreSQL, key: []string{"order"}, conflict: sqlload.ConflictReplace, want: `INSERT INTO "planets" ("order", "name") VALUES ($1, $2) ON CONFLICT ("order") DO UPDATE SET "name" = EXCLUDED."name"`},
+		{dialect: sqlload.PostgreSQL, key: []string{"order", "name"}, conflict: sqlload.ConflictReplace, want: `INSERT INTO "planets" ("order", "name") VALUES ($1, $2) ON CONFLICT ("order", "name") DO NOTHING`},
+		{dialect: sqlload.MySQL, key: []string{"order"}, conflict: sqlload.ConflictReplace, want: "INSERT INTO `planets` (`order`, `name`) VALUES (?, ?) ON DUPLICATE KEY UPDATE `name` = VALUES(`name`)"},
+		{dialect: sqlload.MySQL, conflict: sqlload.ConflictReplace, want: "REPLACE INTO `planets` (`order`, `name`) VALUES (?, ?)"},
+	}
+	for _, tc := range testCases {
+		got, err := tc.dialect.Insert("planets", []string{"order", "name"}, tc.key, tc.conflict)
+		if err != nil {
+			t.Errorf("Insert() is \"%+v\", want nil.", err)
+			continue
+		}
+		if got != tc.want {
+			t.Errorf("Insert() is %q, want %q.", got, tc.want)
+		}
+	}
+	if _, err := sqlload.PostgreSQL.Insert("planets", []string{"order", "name"}, nil, sqlload.ConflictReplace); !errors.Is(err, sqlload.ErrNoKey) {
+		t.Errorf("Insert() is \"%+v\", want \"%+v\".", err, sqlload.ErrNoKey)
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -5,9 +5,7 @@ package main
 
 import (
 	_ "embed"
-	"errors"
 	"fmt"
-	"io"
 	"os"
 	"strings"
 
@@ -20,34 +18,30 @@ var planets string
 func main() {
 	rc := csvdata.NewRows(csvdata.New(strings.NewReader(planets)), true)
 	defer rc.Close() //dummy
-	for {
-		if err := rc.Next(); err != nil {
-			if errors.Is(err, io.EOF) {
-				break
-			}
-			fmt.Fprintln(os.Stderr, err)
-			return
-		}
-		order, err := rc.ColumnInt64("order", 10)
+	for _, row := range rc.All() {
+		order, err := row.ColumnInt64("order", 10)
 		if err != nil {
 			fmt.Fprintln(os.Stderr, err)
 			return
 		}
 		fmt.Println("    Order =", order)
-		fmt.Println("     Name =", rc.Column("name"))
-		mass, err := rc.ColumnFloat64("mass")
+		fmt.Println("     Name =", row.Column("name"))
+		mass, err := row.ColumnFloat64("mass")
 		if err != nil {
 			fmt.Fprintln(os.Stderr, err)
 			return
 		}
 		fmt.Println("     Mass =", mass)
-		habitable, err := rc.ColumnBool("habitable")
+		habitable, err := row.ColumnBool("habitable")
 		if err != nil {
 			fmt.Fprintln(os.Stderr, err)
 			return
 		}
 		fmt.Println("Habitable =", habitable)
 	}
+	if err := rc.Err(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+	}
 }
 
 /* Copyright 2021 Spiegel
@@ -0,0 +1,132 @@
+package csvdata
+
+import "slices"
+
+// TrimMode is set of flags for trimming empty rows and columns in spreadsheet readers.
+type TrimMode int
+
+const (
+	TrimTrailingRows    TrimMode = 1 << iota // stop at the last non-empty row
+	TrimEmptyRows                            // skip all empty rows
+	TrimTrailingColumns                      // remove empty fields at the end of each row
+	TrimAll             = TrimTrailingRows | TrimEmptyRows | TrimTrailingColumns
+)
+
+// IsEmptyRecord function returns true if all fields in record are empty.
+func IsEmptyRecord(record []string) bool {
+	for _, s := range record {
+		if len(s) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// TrimRecord function removes empty fields at the end of record.
+func TrimRecord(record []string) []string {
+	n := len(record)
+	for n > 0 && len(record[n-1]) == 0 {
+		n--
+	}
+	return record[:n]
+}
+
+// Trimmer is helper of RowsReader implementation for trimming empty rows and columns.
+// Zero value does not trim anything.
+type Trimmer struct {
+	Mode      TrimMode
+	empties   []emptyRows // empty rows held
+	held      []string    // non-empty record following held empty rows
+	heldRow   int
+	repeated  []string // record of repeated rows to be returned
+	repeats   int      // number of repeated rows remaining
+	repeatRow int      // row number of the last returned repeated row
+}
+
+// emptyRows is block of held empty rows with the same width.
+type emptyRows struct {
+	record []string
+	row, n int // row number of the first row and number of rows
+}
+
+// Read method reads record and its row number by read function, and returns it trimmed by Mode.
+// In TrimTrailingRows mode, empty rows are held until the next non-empty row is read, and dropped at the end of data.
+func (t *Trimmer) Read(read func() ([]string, int, error)) ([]string, int, error) {
+	return t.ReadBlock(func() ([]string, int, int, error) {
+		record, row, err := read()
+		return record, row, 1, err
+	})
+}
+
+// ReadBlock method is the same as Read method, but read function returns block of rows repeating the same record:
+// record, row number of the first row and number of rows. Block of empty rows is trimmed at once.
+func (t *Trimmer) ReadBlock(read func() ([]string, int, int, error)) ([]string, int, error) {
+	for {
+		if t.held != nil {
+			if len(t.empties) > 0 {
+				b := &t.empties[0]
+				record, row := slices.Clone(b.record), b.row
+				if b.row, b.n = b.row+1, b.n-1; b.n == 0 {
+					t.empties = t.empties[1:]
+				}
+				return record, row, nil
+			}
+			record, row := t.held, t.heldRow
+			t.held = nil
+			return record, row, nil
+		}
+		if t.repeats > 0 {
+			t.repeats--
+			t.repeatRow++
+			return slices.Clone(t.repeated), t.repeatRow, nil
+		}
+		record, row, n, err := read()
+		if err != nil {
+			return record, row, err
+		}
+		if t.Mode&TrimTrailingColumns != 0 {
+			record = TrimRecord(record)
+		}
+		empty := IsEmptyRecord(record)
+		if empty {
+			switch {
+			case t.Mode&TrimEmptyRows != 0:
+				continue
+			case t.Mode&TrimTrailingRows != 0:
+				t.holdEmpty(record, row, max(n, 1))
+				continue
+			}
+		}
+		if n > 1 {
+			t.repeated, t.repeats, t.repeatRow = slices.Clone(record), n-1, row
+		}
+		if empty || len(t.empties) == 0 {
+			return record, row, nil
+		}
+		t.held, t.heldRow = record, row
+	}
+}
+
+// holdEmpty method holds n empty rows (original width of record is kept).
+func (t *Trimmer) holdEmpty(record []string, row, n int) {
+	if k := len(t.empties) - 1; k >= 0 && len(t.empties[k].record) == len(record) && t.empties[k].row+t.empties[k].n == row {
+		t.empties[k].n += n
+		return
+	}
+	t.empties = append(t.empties, emptyRows{record: slices.Clone(record), row: row, n: n})
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
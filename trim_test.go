@@ -0,0 +1,134 @@
+package csvdata_test
+
+import (
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/goark/csvdata"
+)
+
+func TestTrimmer(t *testing.T) {
+	input := [][]string{{"a", "", ""}, {"", ""}, {}, {"b", "c", ""}, {"", ""}, {""}}
+	testCases := []struct {
+		mode  csvdata.TrimMode
+		want  [][]string
+		lines []int
+	}{
+		{mode: 0, want: input, lines: []int{1, 2, 3, 4, 5, 6}},
+		{mode: csvdata.TrimTrailingRows, want: [][]string{{"a", "", ""}, {"", ""}, {}, {"b", "c", ""}}, lines: []int{1, 2, 3, 4}},
+		{mode: csvdata.TrimEmptyRows, want: [][]string{{"a", "", ""}, {"b", "c", ""}}, lines: []int{1, 4}},
+		{mode: csvdata.TrimTrailingColumns, want: [][]string{{"a"}, {}, {}, {"b", "c"}, {}, {}}, lines: []int{1, 2, 3, 4, 5, 6}},
+		{mode: csvdata.TrimAll, want: [][]string{{"a"}, {"b", "c"}}, lines: []int{1, 4}},
+	}
+	for _, tc := range testCases {
+		i := 0
+		read := func() ([]string, int, error) {
+			if i >= len(input) {
+				return nil, 0, io.EOF
+			}
+			i++
+			return input[i-1], i, nil
+		}
+		trimmer := &csvdata.Trimmer{Mode: tc.mode}
+		list, lines := [][]string{}, []int{}
+		for {
+			record, line, err := trimmer.Read(read)
+			if err != nil {
+				if !errors.Is(err, io.EOF) {
+					t.Errorf("Read() is \"%+v\", want \"%+v\".", err, io.EOF)
+				}
+				break
+			}
+			list, lines = append(list, record), append(lines, line)
+		}
+		if !reflect.DeepEqual(list, tc.want) {
+			t.Errorf("Read() in mode %v is %q, want %q.", tc.mode, list, tc.want)
+		}
+		if !reflect.DeepEqual(lines, tc.lines) {
+			t.Errorf("row numbers in mode %v are %v, want %v.", tc.mode, lines, tc.lines)
+		}
+	}
+}
+
+func TestTrimmerBlock(t *testing.T) {
+	input := []struct {
+		record []string
+		n      int
+	}{{record: []string{"a"}, n: 1}, {record: []string{"", ""}, n: 3}, {record: []string{"b", "c", ""}, n: 2}, {record: []string{""}, n: 3}}
+	testCases := []struct {
+		mode  csvdata.TrimMode
+		want  [][]string
+		lines []int
+	}{
+		{mode: 0, want: [][]string{{"a"}, {"", ""}, {"", ""}, {"", ""}, {"b", "c", ""}, {"b", "c", ""}, {""}, {""}, {""}}, lines: []int{1, 2, 3, 4, 5, 6, 7, 8, 9}},
+		{mode: csvdata.TrimTrailingRows, want: [][]string{{"a"}, {"", ""}, {"", ""}, {"", ""}, {"b", "c", ""}, {"b", "c", ""}}, lines: []int{1, 2, 3, 4, 5, 6}},
+		{mode: csvdata.TrimEmptyRows, want: [][]string{{"a"}, {"b", "c", ""}, {"b", "c", ""}}, lines: []int{1, 5, 6}},
+		{mode: csvdata.TrimAll, want: [][]string{{"a"}, {"b", "c"}, {"b", "c"}}, lines: []int{1, 5, 6}},
+	}
+	for _, tc := range testCases {
+		i, row := 0, 1
+		read := func() ([]string, int, int, error) {
+			if i >= len(input) {
+				return nil, 0, 0, io.EOF
+			}
+			i++
+			row += input[i-1].n
+			return input[i-1].record, row - input[i-1].n, input[i-1].n, nil
+		}
+		trimmer := &csvdata.Trimmer{Mode: tc.mode}
+		list, lines := [][]string{}, []int{}
+		for {
+			record, line, err := trimmer.ReadBlock(read)
+			if err != nil {
+				if !errors.Is(err, io.EOF) {
+					t.Errorf("ReadBlock() is \"%+v\", want \"%+v\".", err, io.EOF)
+				}
+				break
+			}
+			list, lines = append(list, record), append(lines, line)
+		}
+		if !reflect.DeepEqual(list, tc.want) {
+			t.Errorf("ReadBlock() in mode %v is %q, want %q.", tc.mode, list, tc.want)
+		}
+		if !reflect.DeepEqual(lines, tc.lines) {
+			t.Errorf("row numbers in mode %v are %v, want %v.", tc.mode, lines, tc.lines)
+		}
+	}
+}
+
+func TestTrimRecord(t *testing.T) {
+	testCases := []struct {
+		record []string
+		want   []string
+		empty  bool
+	}{
+		{record: nil, want: nil, empty: true},
+		{record: []string{"", ""}, want: []string{}, empty: true},
+		{record: []string{"", "a", ""}, want: []string{"", "a"}, empty: false},
+	}
+	for _, tc := range testCases {
+		if got := csvdata.TrimRecord(tc.record); len(got) != len(tc.want) || (len(got) > 0 && !reflect.DeepEqual(got, tc.want)) {
+			t.Errorf("TrimRecord(%q) is %q, want %q.", tc.record, got, tc.want)
+		}
+		if got := csvdata.IsEmptyRecord(tc.record); got != tc.empty {
+			t.Errorf("IsEmptyRecord(%q) is %v, want %v.", tc.record, got, tc.empty)
+		}
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,69 @@
+package csvdata
+
+import (
+	"iter"
+
+	"github.com/goark/errs"
+)
+
+// SheetInfo is metadata of a sheet in workbook.
+type SheetInfo struct {
+	Name    string
+	Index   int  // index of sheet (0-based)
+	Visible bool // false if sheet is hidden
+	Rows    int  // number of rows up to the last used row (0 if unknown)
+	Columns int  // number of columns up to the last used column (0 if unknown)
+}
+
+// Workbook is interface type for spreadsheet that has multiple sheets.
+type Workbook interface {
+	Sheets() ([]SheetInfo, error)
+	OpenSheet(name string) (RowsReader, error)
+}
+
+// SheetRows is Rows instance for a sheet in workbook.
+type SheetRows struct {
+	SheetInfo
+	*Rows
+}
+
+// AllSheets function returns iterator over sheets in workbook with Rows instance for each sheet.
+// Error in listing or opening sheets is yielded with nil SheetRows, and iteration stops after that.
+func AllSheets(wb Workbook, headerFlag bool) iter.Seq2[*SheetRows, error] {
+	return func(yield func(*SheetRows, error) bool) {
+		if wb == nil {
+			yield(nil, errs.Wrap(ErrNullPointer))
+			return
+		}
+		sheets, err := wb.Sheets()
+		if err != nil {
+			yield(nil, errs.Wrap(err))
+			return
+		}
+		for _, sheet := range sheets {
+			rr, err := wb.OpenSheet(sheet.Name)
+			if err != nil {
+				yield(nil, errs.Wrap(err, errs.WithContext("sheet", sheet.Name)))
+				return
+			}
+			if !yield(&SheetRows{SheetInfo: sheet, Rows: NewRows(rr, headerFlag)}, nil) {
+				return
+			}
+		}
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
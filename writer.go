@@ -0,0 +1,404 @@
+package csvdata
+
+import (
+	"bufio"
+	"database/sql"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/goark/errs"
+)
+
+// QuotePolicy is policy of quoting fields in Writer.
+type QuotePolicy int
+
+const (
+	QuoteMinimal    QuotePolicy = iota // quotes fields only if needed (default)
+	QuoteAll                           // quotes all fields except null value
+	QuoteNonNumeric                    // quotes all non-numeric fields except null value
+)
+
+// DefaultTimeLayout is layout of time.Time data in Writer. It is compatible with time.RFC3339 layout in Rows.GetTime method.
+const DefaultTimeLayout = time.RFC3339Nano
+
+const bom = "\ufeff"
+
+type field struct {
+	value   string
+	numeric bool
+	null    bool
+}
+
+// Writer is class of CSV writer
+type Writer struct {
+	comma     rune
+	useCRLF   bool
+	bom       bool
+	started   bool
+	quote     QuotePolicy
+	nullToken string
+	record    []field
+	writer    *bufio.Writer
+	closer    func() error
+}
+
+// CreateFile returns CSV file for writing.
+func CreateFile(path string) (*os.File, error) {
+	file, err := os.Create(path)
+	if err != nil {
+		return nil, errs.Wrap(err, errs.WithContext("path", path))
+	}
+	return file, nil
+}
+
+// NewWriter function creates a new Writer instance.
+func NewWriter(w io.Writer) *Writer {
+	closer := func() error { return nil }
+	if c, ok := w.(io.Closer); ok {
+		closer = c.Close
+	}
+	return &Writer{comma: ',', writer: bufio.NewWriter(w), closer: closer}
+}
+
+// WithComma method sets field delimiter.
+func (w *Writer) WithComma(c rune) *Writer {
+	if w == nil {
+		return nil
+	}
+	w.comma = c
+	return w
+}
+
+// WithQuotePolicy method sets quoting policy.
+func (w *Writer) WithQuotePolicy(p QuotePolicy) *Writer {
+	if w == nil {
+		return nil
+	}
+	w.quote = p
+	return w
+}
+
+// WithUseCRLF method sets \r\n as the line terminator.
+func (w *Writer) WithUseCRLF(mode bool) *Writer {
+	if w == nil {
+		return nil
+	}
+	w.useCRLF = mode
+	return w
+}
+
+// WithBOM method sets writing UTF-8 BOM at the beginning.
+func (w *Writer) WithBOM(mode bool) *Writer {
+	if w == nil {
+		return nil
+	}
+	w.bom = mode
+	return w
+}
+
+// WithNullToken method sets string for null value. (default: empty string)
+func (w *Writer) WithNullToken(s string) *Writer {
+	if w == nil {
+		return nil
+	}
+	w.nullToken = s
+	return w
+}
+
+// WriteHeader method writes header record.
+func (w *Writer) WriteHeader(header []string) error {
+	return w.Write(header)
+}
+
+// Write method writes a record. Fields that are already added by WriteXxx methods are written at the head.
+func (w *Writer) Write(record []string) error {
+	if w == nil {
+		return errs.Wrap(ErrNullPointer)
+	}
+	for _, s := range record {
+		w.record = append(w.record, field{value: s, numeric: isNumeric(s)})
+	}
+	return w.EndRecord()
+}
+
+// WriteString method adds string field to current record.
+func (w *Writer) WriteString(s string) error {
+	return w.add(field{value: s})
+}
+
+// WriteNull method adds null field to current record.
+func (w *Writer) WriteNull() error {
+	return w.add(field{null: true})
+}
+
+// WriteBool method adds bool field to current record.
+func (w *Writer) WriteBool(b bool) error {
+	return w.add(field{value: strconv.FormatBool(b)})
+}
+
+// WriteFloat64 method adds float64 field to current record.
+func (w *Writer) WriteFloat64(f float64) error {
+	return w.add(field{value: strconv.FormatFloat(f, 'g', -1, 64), numeric: true})
+}
+
+// WriteInt64 method adds int64 field to current record.
+func (w *Writer) WriteInt64(n int64, base int) error {
+	return w.add(field{value: strconv.FormatInt(n, formatBase(base)), numeric: true})
+}
+
+// WriteTime method adds time.Time field to current record. If layout is empty, DefaultTimeLayout is used.
+func (w *Writer) WriteTime(t time.Time, layout string) error {
+	return w.add(field{value: formatTime(t, layout)})
+}
+
+// WriteNullString method adds sql.NullString field to current record.
+func (w *Writer) WriteNullString(s sql.NullString) error {
+	if !s.Valid {
+		return w.WriteNull()
+	}
+	return w.WriteString(s.String)
+}
+
+// WriteNullBool method adds sql.NullBool field to current record.
+func (w *Writer) WriteNullBool(b sql.NullBool) error {
+	if !b.Valid {
+		return w.WriteNull()
+	}
+	return w.WriteBool(b.Bool)
+}
+
+// WriteNullFloat64 method adds sql.NullFloat64 field to current record.
+func (w *Writer) WriteNullFloat64(f sql.NullFloat64) error {
+	if !f.Valid {
+		return w.WriteNull()
+	}
+	return w.WriteFloat64(f.Float64)
+}
+
+// WriteNullInt64 method adds sql.NullInt64 field to current record.
+func (w *Writer) WriteNullInt64(n sql.NullInt64, base int) error {
+	if !n.Valid {
+		return w.WriteNull()
+	}
+	return w.WriteInt64(n.Int64, base)
+}
+
+// WriteNullInt32 method adds sql.NullInt32 field to current record.
+func (w *Writer) WriteNullInt32(n sql.NullInt32, base int) error {
+	if !n.Valid {
+		return w.WriteNull()
+	}
+	return w.WriteInt64(int64(n.Int32), base)
+}
+
+// WriteNullInt16 method adds sql.NullInt16 field to current record.
+func (w *Writer) WriteNullInt16(n sql.NullInt16, base int) error {
+	if !n.Valid {
+		return w.WriteNull()
+	}
+	return w.WriteInt64(int64(n.Int16), base)
+}
+
+// WriteNullByte method adds sql.NullByte field to current record.
+func (w *Writer) WriteNullByte(n sql.NullByte, base int) error {
+	if !n.Valid {
+		return w.WriteNull()
+	}
+	return w.WriteInt64(int64(n.Byte), base)
+}
+
+// WriteNullTime method adds sql.NullTime field to current record.
+func (w *Writer) WriteNullTime(t sql.NullTime, layout string) error {
+	if !t.Valid {
+		return w.WriteNull()
+	}
+	return w.WriteTime(t.Time, layout)
+}
+
+// EndRecord method writes current record and starts next record.
+func (w *Writer) EndRecord() error {
+	if w == nil {
+		return errs.Wrap(ErrNullPointer)
+	}
+	if !validDelimiter(w.comma) {
+		return errs.Wrap(ErrInvalidDelimiter, errs.WithContext("comma", string(w.comma)))
+	}
+	if !w.started {
+		w.started = true
+		if w.bom {
+			if _, err := w.writer.WriteString(bom); err != nil {
+				return errs.Wrap(err)
+			}
+		}
+	}
+	for i, f := range w.record {
+		if i > 0 {
+			if _, err := w.writer.WriteRune(w.comma); err != nil {
+				return errs.Wrap(err)
+			}
+		}
+		if len(w.record) == 1 && !f.null && len(f.value) == 0 {
+			// a blank line is skipped in reading
+			if _, err := w.writer.WriteString(`""`); err != nil {
+				return errs.Wrap(err)
+			}
+			break
+		}
+		if err := w.writeField(f); err != nil {
+			return errs.Wrap(err)
+		}
+	}
+	w.record = w.record[:0]
+	var err error
+	if w.useCRLF {
+		_, err = w.writer.WriteString("\r\n")
+	} else {
+		err = w.writer.WriteByte('\n')
+	}
+	return errs.Wrap(err)
+}
+
+// Flush method writes any buffered data to the underlying io.Writer.
+func (w *Writer) Flush() error {
+	if w == nil {
+		return errs.Wrap(ErrNullPointer)
+	}
+	return errs.Wrap(w.writer.Flush())
+}
+
+// Close method flushes buffered data and closes the underlying io.Writer if it is io.Closer.
+func (w *Writer) Close() error {
+	if w == nil {
+		return nil
+	}
+	if err := w.Flush(); err != nil {
+		_ = w.closer()
+		return errs.Wrap(err)
+	}
+	return errs.Wrap(w.closer())
+}
+
+func (w *Writer) add(f field) error {
+	if w == nil {
+		return errs.Wrap(ErrNullPointer)
+	}
+	w.record = append(w.record, f)
+	return nil
+}
+
+func (w *Writer) writeField(f field) error {
+	s := f.value
+	if f.null {
+		s = w.nullToken
+	}
+	if !w.needsQuotes(s, f) {
+		_, err := w.writer.WriteString(s)
+		return errs.Wrap(err)
+	}
+	if err := w.writer.WriteByte('"'); err != nil {
+		return errs.Wrap(err)
+	}
+	for len(s) > 0 {
+		i := strings.IndexAny(s, "\"\r\n")
+		if i < 0 {
+			i = len(s)
+		}
+		if _, err := w.writer.WriteString(s[:i]); err != nil {
+			return errs.Wrap(err)
+		}
+		s = s[i:]
+		if len(s) > 0 {
+			var err error
+			switch s[0] {
+			case '"':
+				_, err = w.writer.WriteString(`""`)
+			case '\r':
+				if !w.useCRLF {
+					err = w.writer.WriteByte('\r')
+				}
+			case '\n':
+				if w.useCRLF {
+					_, err = w.writer.WriteString("\r\n")
+				} else {
+					err = w.writer.WriteByte('\n')
+				}
+			}
+			s = s[1:]
+			if err != nil {
+				return errs.Wrap(err)
+			}
+		}
+	}
+	return errs.Wrap(w.writer.WriteByte('"'))
+}
+
+func (w *Writer) needsQuotes(s string, f field) bool {
+	if f.null {
+		return false
+	}
+	switch w.quote {
+	case QuoteAll:
+		return true
+	case QuoteNonNumeric:
+		if !f.numeric {
+			return true
+		}
+	}
+	if len(s) == 0 {
+		return false
+	}
+	if s == `\.` {
+		return true
+	}
+	if w.comma < utf8.RuneSelf {
+		for i := 0; i < len(s); i++ {
+			c := s[i]
+			if c == '\n' || c == '\r' || c == '"' || c == byte(w.comma) {
+				return true
+			}
+		}
+	} else if strings.ContainsRune(s, w.comma) || strings.ContainsAny(s, "\"\r\n") {
+		return true
+	}
+	r, _ := utf8.DecodeRuneInString(s)
+	return unicode.IsSpace(r)
+}
+
+func validDelimiter(r rune) bool {
+	return r != 0 && r != '"' && r != '\r' && r != '\n' && utf8.ValidRune(r) && r != utf8.RuneError
+}
+
+func formatBase(base int) int {
+	if base < 2 || base > 36 {
+		return 10
+	}
+	return base
+}
+
+func isNumeric(s string) bool {
+	if len(s) == 0 {
+		return false
+	}
+	_, err := strconv.ParseFloat(s, 64)
+	return err == nil
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,117 @@
+package csvdata_test
+
+import (
+	"bytes"
+	"database/sql"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/goark/csvdata"
+)
+
+func TestWriter(t *testing.T) {
+	testCases := []struct {
+		w    func(*bytes.Buffer) *csvdata.Writer
+		want string
+	}{
+		{w: func(b *bytes.Buffer) *csvdata.Writer { return csvdata.NewWriter(b) }, want: "name,order,mass,flag,note\nfoo,1,0.055,true,\n\" bar\",ff,1e+21,,\"a,\"\"b\"\"\"\n"},
+		{w: func(b *bytes.Buffer) *csvdata.Writer {
+			return csvdata.NewWriter(b).WithComma('\t').WithUseCRLF(true).WithBOM(true).WithNullToken(`\N`)
+		}, want: "\ufeffname\torder\tmass\tflag\tnote\r\nfoo\t1\t0.055\ttrue\t\\N\r\n\" bar\"\tff\t1e+21\t\\N\t\"a,\"\"b\"\"\"\r\n"},
+		{w: func(b *bytes.Buffer) *csvdata.Writer { return csvdata.NewWriter(b).WithQuotePolicy(csvdata.QuoteAll) }, want: "\"name\",\"order\",\"mass\",\"flag\",\"note\"\n\"foo\",\"1\",\"0.055\",\"true\",\n\" bar\",\"ff\",\"1e+21\",,\"a,\"\"b\"\"\"\n"},
+		{w: func(b *bytes.Buffer) *csvdata.Writer {
+			return csvdata.NewWriter(b).WithQuotePolicy(csvdata.QuoteNonNumeric)
+		}, want: "\"name\",\"order\",\"mass\",\"flag\",\"note\"\n\"foo\",1,0.055,\"true\",\n\" bar\",ff,1e+21,,\"a,\"\"b\"\"\"\n"},
+	}
+	for _, tc := range testCases {
+		buf := &bytes.Buffer{}
+		w := tc.w(buf)
+		if err := w.WriteHeader([]string{"name", "order", "mass", "flag", "note"}); err != nil {
+			t.Errorf("WriteHeader() is \"%+v\", want nil.", err)
+		}
+		_ = w.WriteString("foo")
+		_ = w.WriteInt64(1, 10)
+		_ = w.WriteFloat64(0.055)
+		_ = w.WriteNullBool(sql.NullBool{Bool: true, Valid: true})
+		_ = w.WriteNullString(sql.NullString{})
+		if err := w.EndRecord(); err != nil {
+			t.Errorf("EndRecord() is \"%+v\", want nil.", err)
+		}
+		_ = w.WriteString(" bar")
+		_ = w.WriteNullInt64(sql.NullInt64{Int64: 255, Valid: true}, 16)
+		_ = w.WriteFloat64(1e21)
+		_ = w.WriteNullBool(sql.NullBool{})
+		_ = w.WriteString(`a,"b"`)
+		if err := w.EndRecord(); err != nil {
+			t.Errorf("EndRecord() is \"%+v\", want nil.", err)
+		}
+		if err := w.Close(); err != nil {
+			t.Errorf("Close() is \"%+v\", want nil.", err)
+		}
+		if got := buf.String(); got != tc.want {
+			t.Errorf("Writer output is %q, want %q.", got, tc.want)
+		}
+	}
+}
+
+func TestWriterRoundTrip(t *testing.T) {
+	tm := time.Date(2023, time.March, 14, 15, 9, 26, 535897932, time.FixedZone("JST", 9*60*60))
+	buf := &bytes.Buffer{}
+	w := csvdata.NewWriter(buf)
+	_ = w.WriteHeader([]string{"order", "mass", "habitable", "date", "note"})
+	_ = w.WriteInt64(-42, 10)
+	_ = w.WriteFloat64(0.1 + 0.2)
+	_ = w.WriteBool(true)
+	_ = w.WriteTime(tm, "")
+	_ = w.WriteString(" multi\nline \"note\"")
+	_ = w.EndRecord()
+	if err := w.Flush(); err != nil {
+		t.Fatalf("Flush() is \"%+v\", want nil.", err)
+	}
+
+	rc := csvdata.NewRows(csvdata.New(strings.NewReader(buf.String())), true)
+	if err := rc.Next(); err != nil {
+		t.Fatalf("Next() is \"%+v\", want nil.", err)
+	}
+	if n, err := rc.ColumnInt64("order", 10); err != nil || n != -42 {
+		t.Errorf("ColumnInt64() is %v, %+v, want %v.", n, err, -42)
+	}
+	if f, err := rc.ColumnFloat64("mass"); err != nil || f != 0.1+0.2 {
+		t.Errorf("ColumnFloat64() is %v, %+v, want %v.", f, err, 0.1+0.2)
+	}
+	if b, err := rc.ColumnBool("habitable"); err != nil || !b {
+		t.Errorf("ColumnBool() is %v, %+v, want %v.", b, err, true)
+	}
+	if d, err := rc.ColumnTime("date", ""); err != nil || !d.Equal(tm) {
+		t.Errorf("ColumnTime() is %v, %+v, want %v.", d, err, tm)
+	}
+	if s := rc.Column("note"); s != " multi\nline \"note\"" {
+		t.Errorf("Column() is %q, want %q.", s, " multi\nline \"note\"")
+	}
+}
+
+func TestWriterError(t *testing.T) {
+	if err := (*csvdata.Writer)(nil).WithComma(',').WithBOM(true).WriteString("foo"); !errors.Is(err, csvdata.ErrNullPointer) {
+		t.Errorf("WriteString() is \"%+v\", want \"%+v\".", err, csvdata.ErrNullPointer)
+	}
+	if err := csvdata.NewWriter(&bytes.Buffer{}).WithComma('"').Write([]string{"foo"}); !errors.Is(err, csvdata.ErrInvalidDelimiter) {
+		t.Errorf("Write() is \"%+v\", want \"%+v\".", err, csvdata.ErrInvalidDelimiter)
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */